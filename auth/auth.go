@@ -0,0 +1,205 @@
+// Package auth authenticates gRPC calls by the bearer token in their
+// metadata, checks the caller may make them, and makes the caller's identity
+// available to handlers through the context.
+//
+// Tokens are JWTs signed with HS256 by a key configured on the server; the
+// subject claim is the user id and the role claim the user's Role. What each
+// method needs is given by a Policy.
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Identity is the caller of an RPC. The zero Identity is an anonymous
+// caller.
+type Identity struct {
+	UserID string
+	Role   Role
+}
+
+// Anonymous reports whether the caller presented no token.
+func (i Identity) Anonymous() bool {
+	return i.UserID == ""
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying identity.
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the identity carried by ctx. It reports false when the
+// server does not authenticate calls at all, and true with an anonymous
+// Identity for a call without a token.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// claims are the claims of a token.
+type claims struct {
+	jwt.RegisteredClaims
+	Role Role `json:"role,omitempty"`
+}
+
+// Authenticator checks the tokens of incoming calls against a Policy.
+type Authenticator struct {
+	key    []byte
+	policy Policy
+	now    func() time.Time
+}
+
+// New returns an Authenticator verifying tokens with key and allowing the
+// calls policy permits.
+func New(key []byte, policy Policy) *Authenticator {
+	return &Authenticator{
+		key:    key,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// NewToken returns a token identifying userID, with role, until ttl elapses.
+func (a *Authenticator) NewToken(userID string, role Role, ttl time.Duration) (string, error) {
+	now := a.now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Role: role,
+	})
+	return token.SignedString(a.key)
+}
+
+// Authorize returns the identity of the call of fullMethod with ctx. It
+// returns an Unauthenticated status for a bad token, or for no token when
+// the method needs a user, and a PermissionDenied status when the caller's
+// role is not allowed the method.
+func (a *Authenticator) Authorize(ctx context.Context, fullMethod string) (Identity, error) {
+	permission, ok := a.policy(fullMethod)
+	if !ok {
+		return Identity{}, status.Errorf(codes.PermissionDenied, "%s is not allowed", fullMethod)
+	}
+	identity, err := a.authenticate(ctx)
+	if err != nil {
+		return Identity{}, err
+	}
+	if permission == PermissionPublic {
+		return identity, nil
+	}
+	if identity.Anonymous() {
+		return Identity{}, status.Error(codes.Unauthenticated, "authorization token is required")
+	}
+	if !identity.Role.Can(permission) {
+		return Identity{}, status.Errorf(codes.PermissionDenied,
+			"role %s lacks the %s permission", identity.Role, permission)
+	}
+	return identity, nil
+}
+
+// authenticate returns the identity of the token of ctx, anonymous when
+// there is none.
+func (a *Authenticator) authenticate(ctx context.Context) (Identity, error) {
+	raw, err := bearerToken(ctx)
+	if err != nil || raw == "" {
+		return Identity{}, err
+	}
+
+	tokenClaims := &claims{}
+	_, err = jwt.ParseWithClaims(raw, tokenClaims, func(*jwt.Token) (any, error) {
+		return a.key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(a.now),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return Identity{}, status.Error(codes.Unauthenticated, "authorization token has expired")
+		}
+		return Identity{}, status.Error(codes.Unauthenticated, "invalid authorization token")
+	}
+	if tokenClaims.Subject == "" {
+		return Identity{}, status.Error(codes.Unauthenticated, "authorization token has no subject")
+	}
+	role := tokenClaims.Role
+	if role == "" {
+		role = RoleLearner
+	}
+	if !role.Valid() {
+		return Identity{}, status.Errorf(codes.Unauthenticated, "unknown role %q", role)
+	}
+	return Identity{UserID: tokenClaims.Subject, Role: role}, nil
+}
+
+// UnaryInterceptor authorizes unary calls.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		identity, err := a.Authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(NewContext(ctx, identity), req)
+	}
+}
+
+// StreamInterceptor authorizes streaming calls.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		identity, err := a.Authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{
+			ServerStream: stream,
+			ctx:          NewContext(stream.Context(), identity),
+		})
+	}
+}
+
+// serverStream overrides the context of a grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// bearerToken returns the token of the authorization metadata of ctx, or ""
+// when there is none.
+func bearerToken(ctx context.Context) (string, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return "", nil
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, `authorization must be "Bearer <token>"`)
+	}
+	return strings.TrimSpace(token), nil
+}
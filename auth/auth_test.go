@@ -0,0 +1,377 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	testKey = []byte("test-key")
+	testNow = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+var testMethods = map[string]Permission{
+	"/test.Service/Public": PermissionPublic,
+	"/test.Service/User":   PermissionUser,
+	"/test.Service/Edit":   PermissionEditDictionary,
+}
+
+func testPolicy(fullMethod string) (Permission, bool) {
+	p, ok := testMethods[fullMethod]
+	return p, ok
+}
+
+func newTestAuthenticator() *Authenticator {
+	a := New(testKey, testPolicy)
+	a.now = func() time.Time { return testNow }
+	return a
+}
+
+// sign returns a token of c signed with method and key.
+func sign(t *testing.T, method jwt.SigningMethod, key any, c claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, c).SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+// validClaims returns the claims of a token of userID with role, valid for
+// an hour from testNow.
+func validClaims(userID string, role Role) claims {
+	return claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(testNow),
+			ExpiresAt: jwt.NewNumericDate(testNow.Add(time.Hour)),
+		},
+		Role: role,
+	}
+}
+
+func withAuthorization(value string) context.Context {
+	ctx := context.Background()
+	if value == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", value))
+}
+
+func TestAuthorize(t *testing.T) {
+	bearer := func(token string) string { return "Bearer " + token }
+
+	expired := validClaims("u1", RoleLearner)
+	expired.ExpiresAt = jwt.NewNumericDate(testNow.Add(-time.Minute))
+	noExpiry := validClaims("u1", RoleLearner)
+	noExpiry.ExpiresAt = nil
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		want          Identity
+		wantCode      codes.Code
+	}{
+		{
+			name:   "anonymous public call",
+			method: "/test.Service/Public",
+			want:   Identity{},
+		},
+		{
+			name:     "anonymous user call",
+			method:   "/test.Service/User",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:          "learner user call",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", RoleLearner))),
+			want:          Identity{UserID: "u1", Role: RoleLearner},
+		},
+		{
+			name:          "learner public call keeps the identity",
+			method:        "/test.Service/Public",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", RoleLearner))),
+			want:          Identity{UserID: "u1", Role: RoleLearner},
+		},
+		{
+			name:          "learner edit call",
+			method:        "/test.Service/Edit",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", RoleLearner))),
+			wantCode:      codes.PermissionDenied,
+		},
+		{
+			name:          "editor edit call",
+			method:        "/test.Service/Edit",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("e1", RoleEditor))),
+			want:          Identity{UserID: "e1", Role: RoleEditor},
+		},
+		{
+			name:          "admin edit call",
+			method:        "/test.Service/Edit",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("a1", RoleAdmin))),
+			want:          Identity{UserID: "a1", Role: RoleAdmin},
+		},
+		{
+			name:          "token without role is a learner",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", ""))),
+			want:          Identity{UserID: "u1", Role: RoleLearner},
+		},
+		{
+			name:          "method missing from the policy",
+			method:        "/test.Service/Unknown",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("a1", RoleAdmin))),
+			wantCode:      codes.PermissionDenied,
+		},
+		{
+			name:          "lowercase scheme",
+			method:        "/test.Service/User",
+			authorization: "bearer " + sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", RoleLearner)),
+			want:          Identity{UserID: "u1", Role: RoleLearner},
+		},
+		{
+			name:          "expired token",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, expired)),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "token without expiry",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, noExpiry)),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "token signed with another algorithm",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS512, testKey, validClaims("u1", RoleLearner))),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "unsigned token",
+			method:        "/test.Service/Public",
+			authorization: bearer(sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, validClaims("a1", RoleAdmin))),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "token signed with another key",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, []byte("other-key"), validClaims("u1", RoleLearner))),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "token without subject",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("", RoleLearner))),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "unknown role",
+			method:        "/test.Service/User",
+			authorization: bearer(sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", "owner"))),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "bad token on a public call",
+			method:        "/test.Service/Public",
+			authorization: bearer("not-a-jwt"),
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "scheme other than bearer",
+			method:        "/test.Service/Public",
+			authorization: "Basic dTE6cGFzc3dvcmQ=",
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "bearer without token",
+			method:        "/test.Service/Public",
+			authorization: "Bearer ",
+			wantCode:      codes.Unauthenticated,
+		},
+		{
+			name:          "token without scheme",
+			method:        "/test.Service/Public",
+			authorization: sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", RoleLearner)),
+			wantCode:      codes.Unauthenticated,
+		},
+	}
+
+	a := newTestAuthenticator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authorize(withAuthorization(tt.authorization), tt.method)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("Authorize() code = %v, want %v (error %v)", code, tt.wantCode, err)
+			}
+			if got != tt.want {
+				t.Errorf("Authorize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	a := newTestAuthenticator()
+	token, err := a.NewToken("e1", RoleEditor, time.Hour)
+	if err != nil {
+		t.Fatalf("NewToken() error = %v", err)
+	}
+
+	got, err := a.Authorize(withAuthorization("Bearer "+token), "/test.Service/Edit")
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if want := (Identity{UserID: "e1", Role: RoleEditor}); got != want {
+		t.Errorf("Authorize() = %+v, want %+v", got, want)
+	}
+
+	a.now = func() time.Time { return testNow.Add(2 * time.Hour) }
+	if _, err := a.Authorize(withAuthorization("Bearer "+token), "/test.Service/Edit"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Authorize() after expiry error = %v, want Unauthenticated", err)
+	}
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	a := newTestAuthenticator()
+	learner := "Bearer " + sign(t, jwt.SigningMethodHS256, testKey, validClaims("u1", RoleLearner))
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantCalled    bool
+		want          Identity
+		wantCode      codes.Code
+	}{
+		{
+			name:          "allowed call reaches the handler with the identity",
+			method:        "/test.Service/User",
+			authorization: learner,
+			wantCalled:    true,
+			want:          Identity{UserID: "u1", Role: RoleLearner},
+		},
+		{
+			name:       "anonymous public call reaches the handler",
+			method:     "/test.Service/Public",
+			wantCalled: true,
+			want:       Identity{},
+		},
+		{
+			name:          "denied call does not reach the handler",
+			method:        "/test.Service/Edit",
+			authorization: learner,
+			wantCode:      codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			var got Identity
+			var ok bool
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				got, ok = FromContext(ctx)
+				return "response", nil
+			}
+
+			resp, err := a.UnaryInterceptor()(
+				withAuthorization(tt.authorization),
+				"request",
+				&grpc.UnaryServerInfo{FullMethod: tt.method},
+				handler,
+			)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (error %v)", code, tt.wantCode, err)
+			}
+			if called != tt.wantCalled {
+				t.Fatalf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if !called {
+				return
+			}
+			if resp != "response" {
+				t.Errorf("interceptor response = %v, want the handler's", resp)
+			}
+			if !ok || got != tt.want {
+				t.Errorf("handler identity = %+v, %v, want %+v, true", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+// testStream is a grpc.ServerStream with only a context.
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	a := newTestAuthenticator()
+	editor := "Bearer " + sign(t, jwt.SigningMethodHS256, testKey, validClaims("e1", RoleEditor))
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantCalled    bool
+		want          Identity
+		wantCode      codes.Code
+	}{
+		{
+			name:          "allowed stream reaches the handler with the identity",
+			method:        "/test.Service/Edit",
+			authorization: editor,
+			wantCalled:    true,
+			want:          Identity{UserID: "e1", Role: RoleEditor},
+		},
+		{
+			name:     "anonymous stream needing a user is refused",
+			method:   "/test.Service/User",
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			var got Identity
+			var ok bool
+			handler := func(srv any, stream grpc.ServerStream) error {
+				called = true
+				got, ok = FromContext(stream.Context())
+				return nil
+			}
+
+			err := a.StreamInterceptor()(
+				nil,
+				&testStream{ctx: withAuthorization(tt.authorization)},
+				&grpc.StreamServerInfo{FullMethod: tt.method, IsServerStream: true},
+				handler,
+			)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v (error %v)", code, tt.wantCode, err)
+			}
+			if called != tt.wantCalled {
+				t.Fatalf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if called && (!ok || got != tt.want) {
+				t.Errorf("handler identity = %+v, %v, want %+v, true", got, ok, tt.want)
+			}
+		})
+	}
+}
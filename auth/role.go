@@ -0,0 +1,74 @@
+package auth
+
+// Role is what a user is to the service, carried in the "role" claim of
+// their token.
+type Role string
+
+const (
+	// RoleLearner is an ordinary user, and the role of a token without one.
+	RoleLearner Role = "learner"
+	// RoleEditor curates dictionary entries.
+	RoleEditor Role = "editor"
+	// RoleAdmin may do everything, including undoing the edits of other
+	// editors.
+	RoleAdmin Role = "admin"
+)
+
+// Permission is what a call needs the caller to be allowed.
+type Permission int
+
+const (
+	// PermissionPublic allows anyone, anonymous callers included.
+	PermissionPublic Permission = iota
+	// PermissionUser allows any authenticated user to act on their own data.
+	PermissionUser
+	// PermissionEditDictionary allows changing dictionary entries.
+	PermissionEditDictionary
+	// PermissionRevertOthers allows reverting dictionary edits made by
+	// someone else.
+	PermissionRevertOthers
+)
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionPublic:
+		return "public"
+	case PermissionUser:
+		return "user"
+	case PermissionEditDictionary:
+		return "edit dictionary"
+	case PermissionRevertOthers:
+		return "revert others' edits"
+	default:
+		return "unknown"
+	}
+}
+
+var rolePermissions = map[Role][]Permission{
+	RoleLearner: {PermissionPublic, PermissionUser},
+	RoleEditor:  {PermissionPublic, PermissionUser, PermissionEditDictionary},
+}
+
+// Valid reports whether r is a known role.
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok || r == RoleAdmin
+}
+
+// Can reports whether r is granted p.
+func (r Role) Can(p Permission) bool {
+	if r == RoleAdmin {
+		return true
+	}
+	for _, granted := range rolePermissions[r] {
+		if granted == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy returns the permission a call of fullMethod, in the
+// "/package.Service/Method" form, needs. It reports false for a method it
+// does not know, which no one may call.
+type Policy func(fullMethod string) (Permission, bool)
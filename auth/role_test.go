@@ -0,0 +1,54 @@
+package auth
+
+import "testing"
+
+func TestRoleCan(t *testing.T) {
+	permissions := []Permission{
+		PermissionPublic,
+		PermissionUser,
+		PermissionEditDictionary,
+		PermissionRevertOthers,
+	}
+	tests := []struct {
+		role    Role
+		granted []Permission
+	}{
+		{RoleLearner, []Permission{PermissionPublic, PermissionUser}},
+		{RoleEditor, []Permission{PermissionPublic, PermissionUser, PermissionEditDictionary}},
+		{RoleAdmin, permissions},
+		{Role("owner"), nil},
+		{Role(""), nil},
+	}
+
+	for _, tt := range tests {
+		granted := make(map[Permission]bool)
+		for _, p := range tt.granted {
+			granted[p] = true
+		}
+		for _, p := range permissions {
+			if got := tt.role.Can(p); got != granted[p] {
+				t.Errorf("Role(%q).Can(%s) = %v, want %v", tt.role, p, got, granted[p])
+			}
+		}
+	}
+}
+
+func TestRoleValid(t *testing.T) {
+	tests := []struct {
+		role Role
+		want bool
+	}{
+		{RoleLearner, true},
+		{RoleEditor, true},
+		{RoleAdmin, true},
+		{Role("owner"), false},
+		{Role(""), false},
+		{Role("Admin"), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Valid(); got != tt.want {
+			t.Errorf("Role(%q).Valid() = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+}
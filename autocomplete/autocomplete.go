@@ -0,0 +1,164 @@
+// Package autocomplete completes word prefixes from a sorted in-memory index
+// of dictionary headwords.
+package autocomplete
+
+import (
+	"container/heap"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Completion is a headword starting with the requested prefix.
+type Completion struct {
+	Word          string
+	PartsOfSpeech []string
+	// Senses is the number of word meanings indexed for Word.
+	Senses int
+}
+
+// Index holds headwords sorted for prefix lookups. It is safe for concurrent
+// use.
+type Index struct {
+	mu      sync.RWMutex
+	entries map[string]*Completion
+	// sorted holds the keys of entries in order; it is rebuilt lazily after
+	// entries change.
+	sorted []string
+	dirty  bool
+}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{
+		entries: make(map[string]*Completion),
+	}
+}
+
+// Add indexes one word meaning of word.
+func (i *Index) Add(word, partOfSpeech string) {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" {
+		return
+	}
+	partOfSpeech = strings.TrimSpace(partOfSpeech)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	entry, ok := i.entries[word]
+	if !ok {
+		entry = &Completion{Word: word}
+		i.entries[word] = entry
+		i.dirty = true
+	}
+	entry.Senses++
+	if partOfSpeech != "" && !slices.Contains(entry.PartsOfSpeech, partOfSpeech) {
+		entry.PartsOfSpeech = append(entry.PartsOfSpeech, partOfSpeech)
+	}
+}
+
+// Remove drops word and all its meanings from the index.
+func (i *Index) Remove(word string) {
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if _, ok := i.entries[word]; ok {
+		delete(i.entries, word)
+		i.dirty = true
+	}
+}
+
+// Complete returns up to limit headwords starting with prefix. An exact match
+// ranks first, then words with more senses, then shorter words.
+func (i *Index) Complete(prefix string, limit int) []Completion {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	i.mu.RLock()
+	if i.dirty {
+		i.mu.RUnlock()
+		i.rebuild()
+		i.mu.RLock()
+	}
+	defer i.mu.RUnlock()
+
+	top := &completionHeap{prefix: prefix}
+	start := sort.SearchStrings(i.sorted, prefix)
+	for _, word := range i.sorted[start:] {
+		if !strings.HasPrefix(word, prefix) {
+			break
+		}
+		entry, ok := i.entries[word]
+		if !ok {
+			continue
+		}
+		heap.Push(top, entry)
+		if top.Len() > limit {
+			heap.Pop(top)
+		}
+	}
+
+	completions := make([]Completion, top.Len())
+	for n := len(completions) - 1; n >= 0; n-- {
+		entry := heap.Pop(top).(*Completion)
+		completions[n] = Completion{
+			Word:          entry.Word,
+			PartsOfSpeech: slices.Clone(entry.PartsOfSpeech),
+			Senses:        entry.Senses,
+		}
+	}
+	return completions
+}
+
+func (i *Index) rebuild() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.dirty {
+		return
+	}
+	i.sorted = i.sorted[:0]
+	for word := range i.entries {
+		i.sorted = append(i.sorted, word)
+	}
+	sort.Strings(i.sorted)
+	i.dirty = false
+}
+
+// completionHeap is a min-heap on rank, so the worst of the best completions
+// seen so far is on top.
+type completionHeap struct {
+	prefix  string
+	entries []*Completion
+}
+
+// better reports whether a ranks above b.
+func (h *completionHeap) better(a, b *Completion) bool {
+	if ae, be := a.Word == h.prefix, b.Word == h.prefix; ae != be {
+		return ae
+	}
+	if a.Senses != b.Senses {
+		return a.Senses > b.Senses
+	}
+	if len(a.Word) != len(b.Word) {
+		return len(a.Word) < len(b.Word)
+	}
+	return a.Word < b.Word
+}
+
+func (h *completionHeap) Len() int           { return len(h.entries) }
+func (h *completionHeap) Less(i, j int) bool { return h.better(h.entries[j], h.entries[i]) }
+func (h *completionHeap) Swap(i, j int)      { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+func (h *completionHeap) Push(x any)         { h.entries = append(h.entries, x.(*Completion)) }
+
+func (h *completionHeap) Pop() any {
+	last := h.entries[len(h.entries)-1]
+	h.entries = h.entries[:len(h.entries)-1]
+	return last
+}
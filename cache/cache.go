@@ -0,0 +1,249 @@
+// Package cache keeps the results of dictionary lookups in memory, so that
+// repeated lookups of common words do not reach the backend.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// Defaults used for zero Config fields.
+const (
+	DefaultSize        = 10000
+	DefaultTTL         = time.Hour
+	DefaultNegativeTTL = 5 * time.Minute
+)
+
+// Config sizes a Cache.
+type Config struct {
+	// Size is the number of words kept; the least recently used word is
+	// evicted beyond it.
+	Size int
+	// TTL is how long the meanings of a word found are kept.
+	TTL time.Duration
+	// NegativeTTL is how long a word not found is remembered as such.
+	NegativeTTL time.Duration
+}
+
+// Stats counts the outcomes of Cache lookups.
+type Stats struct {
+	// Hits counts lookups answered from the cache, NegativeHits among them.
+	Hits         uint64
+	NegativeHits uint64
+	// Misses counts lookups that went to the backend, or joined an identical
+	// lookup already in flight, Shared among them.
+	Misses    uint64
+	Shared    uint64
+	Evictions uint64
+	// Size is the number of words cached.
+	Size int
+}
+
+// LogValue reports s as a group of attributes.
+func (s Stats) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Uint64("hits", s.Hits),
+		slog.Uint64("negative_hits", s.NegativeHits),
+		slog.Uint64("misses", s.Misses),
+		slog.Uint64("shared", s.Shared),
+		slog.Uint64("evictions", s.Evictions),
+		slog.Int("size", s.Size),
+	)
+}
+
+// LoadFunc looks a word up in the backend.
+type LoadFunc func(ctx context.Context) ([]*pb.WordMeaning, error)
+
+type entry struct {
+	word         string
+	wordMeanings []*pb.WordMeaning
+	expires      time.Time
+}
+
+// Cache is an LRU cache of word lookups with expiry. It is safe for
+// concurrent use.
+type Cache struct {
+	cfg Config
+	now func() time.Time
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+	// generation changes on every invalidation, so a load started before it
+	// does not store what may be a stale result.
+	generation uint64
+
+	group singleflight.Group
+
+	hits, negativeHits, misses, shared, evictions atomic.Uint64
+}
+
+// New returns an empty Cache.
+func New(cfg Config) *Cache {
+	if cfg.Size <= 0 {
+		cfg.Size = DefaultSize
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultTTL
+	}
+	if cfg.NegativeTTL <= 0 {
+		cfg.NegativeTTL = DefaultNegativeTTL
+	}
+	return &Cache{
+		cfg:     cfg,
+		now:     time.Now,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the cached meanings of word, calling load on a miss. Concurrent
+// misses of the same word share a single call of load, which keeps running
+// when the caller that started it gives up. Errors are not cached.
+//
+// The returned meanings are copies the caller may change.
+func (c *Cache) Get(ctx context.Context, word string, load LoadFunc) ([]*pb.WordMeaning, error) {
+	if wordMeanings, ok := c.get(word); ok {
+		c.hits.Add(1)
+		if len(wordMeanings) == 0 {
+			c.negativeHits.Add(1)
+		}
+		return clone(wordMeanings), nil
+	}
+	c.misses.Add(1)
+
+	leader := false
+	ch := c.group.DoChan(word, func() (any, error) {
+		leader = true
+		c.mu.Lock()
+		generation := c.generation
+		c.mu.Unlock()
+
+		wordMeanings, err := load(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+		wordMeanings = clone(wordMeanings)
+		c.put(word, wordMeanings, generation)
+		return wordMeanings, nil
+	})
+
+	select {
+	case res := <-ch:
+		if !leader {
+			c.shared.Add(1)
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return clone(res.Val.([]*pb.WordMeaning)), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Invalidate drops word from the cache, along with any lookup of it in
+// flight.
+func (c *Cache) Invalidate(word string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[word]; ok {
+		c.order.Remove(el)
+		delete(c.entries, word)
+	}
+	c.generation++
+	c.group.Forget(word)
+}
+
+// Purge empties the cache.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+	c.generation++
+}
+
+// Stats returns the counters of the cache so far.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	size := len(c.entries)
+	c.mu.Unlock()
+
+	return Stats{
+		Hits:         c.hits.Load(),
+		NegativeHits: c.negativeHits.Load(),
+		Misses:       c.misses.Load(),
+		Shared:       c.shared.Load(),
+		Evictions:    c.evictions.Load(),
+		Size:         size,
+	}
+}
+
+func (c *Cache) get(word string) ([]*pb.WordMeaning, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[word]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if !c.now().Before(e.expires) {
+		c.order.Remove(el)
+		delete(c.entries, word)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return e.wordMeanings, true
+}
+
+func (c *Cache) put(word string, wordMeanings []*pb.WordMeaning, generation uint64) {
+	ttl := c.cfg.TTL
+	if len(wordMeanings) == 0 {
+		ttl = c.cfg.NegativeTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+	e := &entry{
+		word:         word,
+		wordMeanings: wordMeanings,
+		expires:      c.now().Add(ttl),
+	}
+	if el, ok := c.entries[word]; ok {
+		el.Value = e
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[word] = c.order.PushFront(e)
+	for c.order.Len() > c.cfg.Size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).word)
+		c.evictions.Add(1)
+	}
+}
+
+func clone(wordMeanings []*pb.WordMeaning) []*pb.WordMeaning {
+	clones := make([]*pb.WordMeaning, 0, len(wordMeanings))
+	for _, wordMeaning := range wordMeanings {
+		clones = append(clones, proto.Clone(wordMeaning).(*pb.WordMeaning))
+	}
+	return clones
+}
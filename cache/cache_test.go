@@ -0,0 +1,220 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// clock is a settable time source for Cache.now.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestCache(cfg Config) (*Cache, *clock) {
+	clk := &clock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := New(cfg)
+	c.now = clk.Now
+	return c, clk
+}
+
+// loader returns a LoadFunc answering word with one meaning, or none when
+// found is false, and counts its calls.
+func loader(word string, found bool, calls *atomic.Int32) LoadFunc {
+	return func(context.Context) ([]*pb.WordMeaning, error) {
+		calls.Add(1)
+		if !found {
+			return nil, nil
+		}
+		return []*pb.WordMeaning{{Id: word, Word: word}}, nil
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	tests := []struct {
+		name    string
+		found   bool
+		advance time.Duration
+		want    int32
+	}{
+		{"found, before TTL", true, 59 * time.Minute, 1},
+		{"found, at TTL", true, time.Hour, 2},
+		{"not found, before negative TTL", false, 4 * time.Minute, 1},
+		{"not found, at negative TTL", false, 5 * time.Minute, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, clk := newTestCache(Config{Size: 10, TTL: time.Hour, NegativeTTL: 5 * time.Minute})
+			var calls atomic.Int32
+			load := loader("run", tt.found, &calls)
+
+			if _, err := c.Get(context.Background(), "run", load); err != nil {
+				t.Fatal(err)
+			}
+			clk.Advance(tt.advance)
+			if _, err := c.Get(context.Background(), "run", load); err != nil {
+				t.Fatal(err)
+			}
+			if got := calls.Load(); got != tt.want {
+				t.Errorf("load called %d times, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEviction(t *testing.T) {
+	c, _ := newTestCache(Config{Size: 2})
+	calls := make(map[string]*atomic.Int32)
+	get := func(word string) {
+		t.Helper()
+		if calls[word] == nil {
+			calls[word] = &atomic.Int32{}
+		}
+		if _, err := c.Get(context.Background(), word, loader(word, true, calls[word])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	get("a")
+	get("b")
+	get("a") // a is now the most recently used, so b goes first.
+	get("c")
+	get("a")
+	get("b")
+
+	want := map[string]int32{"a": 1, "b": 2, "c": 1}
+	for word, n := range want {
+		if got := calls[word].Load(); got != n {
+			t.Errorf("%s loaded %d times, want %d", word, got, n)
+		}
+	}
+	stats := c.Stats()
+	if stats.Evictions != 2 || stats.Size != 2 {
+		t.Errorf("Stats() = %+v, want 2 evictions and size 2", stats)
+	}
+}
+
+func TestSharedMiss(t *testing.T) {
+	c, _ := newTestCache(Config{Size: 10})
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	load := func(context.Context) ([]*pb.WordMeaning, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return []*pb.WordMeaning{{Id: "run", Word: "run"}}, nil
+	}
+
+	const callers = 5
+	var wg sync.WaitGroup
+	results := make(chan []*pb.WordMeaning, callers)
+	get := func() {
+		defer wg.Done()
+		wordMeanings, err := c.Get(context.Background(), "run", load)
+		if err != nil {
+			t.Error(err)
+		}
+		results <- wordMeanings
+	}
+	wg.Add(1)
+	go get()
+	<-started
+	for i := 1; i < callers; i++ {
+		wg.Add(1)
+		go get()
+	}
+	for c.Stats().Misses < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("load called %d times, want 1", got)
+	}
+	for wordMeanings := range results {
+		if len(wordMeanings) != 1 || wordMeanings[0].GetWord() != "run" {
+			t.Errorf("Get() = %v, want the meaning of run", wordMeanings)
+		}
+	}
+}
+
+func TestInvalidateDuringLoad(t *testing.T) {
+	c, _ := newTestCache(Config{Size: 10})
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	stale := func(context.Context) ([]*pb.WordMeaning, error) {
+		calls.Add(1)
+		close(started)
+		<-release
+		return []*pb.WordMeaning{{Id: "run", Definition: "stale"}}, nil
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := c.Get(context.Background(), "run", stale)
+		done <- err
+	}()
+	<-started
+	c.Invalidate("run")
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	fresh := func(context.Context) ([]*pb.WordMeaning, error) {
+		calls.Add(1)
+		return []*pb.WordMeaning{{Id: "run", Definition: "fresh"}}, nil
+	}
+	wordMeanings, err := c.Get(context.Background(), "run", fresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := wordMeanings[0].GetDefinition(); got != "fresh" {
+		t.Errorf("Get() after Invalidate returned the %s result", got)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("load called %d times, want 2", got)
+	}
+}
+
+func TestCopies(t *testing.T) {
+	c, _ := newTestCache(Config{Size: 10})
+	var calls atomic.Int32
+	load := loader("run", true, &calls)
+
+	first, err := c.Get(context.Background(), "run", load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first[0].Word = "changed"
+	second, err := c.Get(context.Background(), "run", load)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second[0].GetWord() != "run" {
+		t.Errorf("a change to a returned meaning reached the cache: %q", second[0].GetWord())
+	}
+}
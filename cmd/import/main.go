@@ -0,0 +1,607 @@
+// Command import preloads a dictdb file, either by resolving every word of a
+// newline-delimited word list through a dictionary source, or by importing
+// the WordMeaning entries of a JSONL dump.
+//
+// Progress is checkpointed next to the input, so an interrupted import
+// started again with the same flags carries on where it stopped. Entries are
+// written to the file once per checkpoint, in one transaction. The
+// checkpoint counts the outcome of the lines already done, and their failures
+// are appended to a file beside it, so the summary and the -failures file of
+// a resumed import cover the whole input.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kakurineuin/learn-english-word/dictdb"
+	"github.com/kakurineuin/learn-english-word/dictpage"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/service"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+// maxListedFailures caps the failures printed in the summary; -failures
+// writes them all.
+const maxListedFailures = 20
+
+type config struct {
+	db         string
+	words      string
+	entries    string
+	source     string
+	dictFile   string
+	pagesDir   string
+	failures   string
+	progress   time.Duration
+	checkpoint int
+	restart    bool
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.db, "db", "", "dictdb file to import into")
+	flag.StringVar(&cfg.words, "words", "", "newline-delimited list of words to resolve through -source")
+	flag.StringVar(&cfg.entries, "entries", "", "JSONL file of WordMeaning entries to import as they are")
+	flag.StringVar(&cfg.source, "source", "pages", "source resolving -words: pages or file")
+	flag.StringVar(&cfg.dictFile, "dict-file", "", "JSON or JSONL dump read by the file source")
+	flag.StringVar(&cfg.pagesDir, "pages-dir", "", "directory of saved dictionary pages, named <word>.html, read by the pages source")
+	flag.StringVar(&cfg.failures, "failures", "", "file to write every failed line to")
+	flag.DurationVar(&cfg.progress, "progress", 5*time.Second, "how often to report progress")
+	flag.IntVar(&cfg.checkpoint, "checkpoint-every", 100, "lines between two checkpoints")
+	flag.BoolVar(&cfg.restart, "restart", false, "ignore any checkpoint and start from the first line")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	if err := run(cfg, logger); err != nil {
+		logger.Error("import failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg config, logger *slog.Logger) error {
+	if cfg.db == "" {
+		return errors.New("-db is required")
+	}
+	if (cfg.words == "") == (cfg.entries == "") {
+		return errors.New("exactly one of -words and -entries is required")
+	}
+
+	db, err := dictdb.Open(cfg.db)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	b := newBatch(db)
+	var imp *importer
+	if cfg.words != "" {
+		source, err := newSource(cfg)
+		if err != nil {
+			return err
+		}
+		imp = newImporter(cfg, cfg.words, b, wordImport(b, source))
+	} else {
+		imp = newImporter(cfg, cfg.entries, b, entryImport(b))
+	}
+
+	err = imp.run(ctx, logger)
+	imp.summary.log(logger)
+	if cfg.failures != "" {
+		if err := imp.summary.writeFailures(cfg.failures); err != nil {
+			return err
+		}
+	}
+	if errors.Is(err, context.Canceled) {
+		logger.Info("import interrupted; run again to resume", "checkpoint", imp.checkpointPath)
+		return nil
+	}
+	return err
+}
+
+func newSource(cfg config) (service.DictionarySource, error) {
+	switch cfg.source {
+	case "pages":
+		if cfg.pagesDir == "" {
+			return nil, errors.New("-pages-dir is required by the pages source")
+		}
+		return pageSource{dir: cfg.pagesDir}, nil
+	case "file":
+		if cfg.dictFile == "" {
+			return nil, errors.New("-dict-file is required by the file source")
+		}
+		return service.LoadFileSource(cfg.dictFile)
+	default:
+		return nil, fmt.Errorf("unknown dictionary source %q", cfg.source)
+	}
+}
+
+// pageSource resolves a word from its saved dictionary page, <dir>/<word>.html.
+type pageSource struct {
+	dir string
+}
+
+func (p pageSource) FindWord(_ context.Context, word string) ([]*pb.WordMeaning, error) {
+	wordMeanings, err := dictpage.ParseFile(filepath.Join(p.dir, word+".html"))
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, dictpage.ErrNoEntries) {
+		return nil, nil
+	}
+	return wordMeanings, err
+}
+
+// errNotFound marks a word the source does not know.
+var errNotFound = errors.New("word not found")
+
+// errSkipped marks a line already imported by an earlier run.
+var errSkipped = errors.New("already imported")
+
+// wordImport resolves a word through source and stores its meanings.
+func wordImport(b *batch, source service.DictionarySource) func(context.Context, []byte) error {
+	return func(ctx context.Context, line []byte) error {
+		word := strings.ToLower(strings.TrimSpace(string(line)))
+		if word == "" || strings.HasPrefix(word, "#") {
+			return errSkipped
+		}
+		if _, found, err := b.get(word); err != nil {
+			return err
+		} else if found {
+			return errSkipped
+		}
+		wordMeanings, err := source.FindWord(ctx, word)
+		if err != nil {
+			return err
+		}
+		if len(wordMeanings) == 0 {
+			return errNotFound
+		}
+		for _, wordMeaning := range wordMeanings {
+			service.FillQueryByWords(wordMeaning, word)
+		}
+		b.put(word, wordMeanings)
+		return nil
+	}
+}
+
+// entryImport stores a WordMeaning in the entry of its headword, replacing
+// the meaning with the same id, so a dump can be imported again. A meaning
+// without order_by_no is numbered after those its headword already has. An
+// id taken by an earlier line of the same run, or by another headword, fails
+// the line with service.ErrDuplicateID.
+func entryImport(b *batch) func(context.Context, []byte) error {
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	imported := make(map[string]bool)
+	return func(_ context.Context, line []byte) error {
+		wordMeaning := &pb.WordMeaning{}
+		if err := unmarshal.Unmarshal(line, wordMeaning); err != nil {
+			return err
+		}
+		word := strings.ToLower(strings.TrimSpace(wordMeaning.GetWord()))
+		if word == "" {
+			return errors.New("word is required")
+		}
+		wordMeaning.Word = word
+
+		wordMeanings, _, err := b.get(word)
+		if err != nil {
+			return err
+		}
+		if wordMeaning.GetOrderByNo() == 0 {
+			var last int32
+			for _, existing := range wordMeanings {
+				last = max(last, existing.GetOrderByNo())
+			}
+			wordMeaning.OrderByNo = last + 1
+		}
+		if wordMeaning.GetId() == "" {
+			wordMeaning.Id = store.WordMeaningID(
+				word,
+				wordMeaning.GetPartOfSpeech(),
+				wordMeaning.GetOrderByNo(),
+			)
+		}
+		id := wordMeaning.GetId()
+		if imported[id] {
+			return fmt.Errorf("%w: %q is on an earlier line", service.ErrDuplicateID, id)
+		}
+		if headword, found, err := b.headword(id); err != nil {
+			return err
+		} else if found && headword != word {
+			return fmt.Errorf("%w: %q belongs to %q", service.ErrDuplicateID, id, headword)
+		}
+		service.FillQueryByWords(wordMeaning, word)
+
+		replaced := false
+		for i, existing := range wordMeanings {
+			if existing.GetId() == id {
+				wordMeanings[i] = wordMeaning
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			wordMeanings = append(wordMeanings, wordMeaning)
+		}
+		b.put(word, wordMeanings)
+		imported[id] = true
+		return nil
+	}
+}
+
+// batch holds the entries changed since the last checkpoint, so they reach
+// the file in one transaction rather than one per line.
+type batch struct {
+	db      *dictdb.DB
+	entries map[string][]*pb.WordMeaning
+	ids     map[string]string
+}
+
+func newBatch(db *dictdb.DB) *batch {
+	return &batch{
+		db:      db,
+		entries: make(map[string][]*pb.WordMeaning),
+		ids:     make(map[string]string),
+	}
+}
+
+// get returns the entry of headword, as put since the last flush or else as
+// stored in the file.
+func (b *batch) get(headword string) ([]*pb.WordMeaning, bool, error) {
+	if wordMeanings, ok := b.entries[headword]; ok {
+		return wordMeanings, true, nil
+	}
+	return b.db.Get(headword)
+}
+
+// headword returns the headword of the entry holding the word meaning id.
+func (b *batch) headword(id string) (string, bool, error) {
+	if headword, ok := b.ids[id]; ok {
+		return headword, true, nil
+	}
+	return b.db.Headword(id)
+}
+
+func (b *batch) put(headword string, wordMeanings []*pb.WordMeaning) {
+	b.entries[headword] = wordMeanings
+	for _, wordMeaning := range wordMeanings {
+		b.ids[wordMeaning.GetId()] = headword
+	}
+}
+
+// flush writes the entries put since the last flush to the file.
+func (b *batch) flush() error {
+	if len(b.entries) == 0 {
+		return nil
+	}
+	if err := b.db.PutAll(b.entries); err != nil {
+		return err
+	}
+	clear(b.entries)
+	clear(b.ids)
+	return nil
+}
+
+// importer feeds the lines of an input file to an import function,
+// checkpointing the number of lines done.
+type importer struct {
+	cfg            config
+	input          string
+	checkpointPath string
+	failuresPath   string
+	batch          *batch
+	importLine     func(context.Context, []byte) error
+	summary        summary
+	// savedFailures is the number of summary failures in failuresPath.
+	savedFailures int
+}
+
+func newImporter(cfg config, input string, b *batch, importLine func(context.Context, []byte) error) *importer {
+	checkpointPath := input + ".checkpoint"
+	return &importer{
+		cfg:            cfg,
+		input:          input,
+		checkpointPath: checkpointPath,
+		failuresPath:   checkpointPath + ".failures",
+		batch:          b,
+		importLine:     importLine,
+	}
+}
+
+func (imp *importer) run(ctx context.Context, logger *slog.Logger) error {
+	start := 0
+	if imp.cfg.restart {
+		if err := os.Remove(imp.failuresPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	} else {
+		saved, err := readCheckpoint(imp.checkpointPath)
+		if err != nil {
+			return err
+		}
+		failures, err := readFailures(imp.failuresPath, saved.Failed)
+		if err != nil {
+			return err
+		}
+		start = saved.Line
+		imp.summary = summary{imported: saved.Imported, skipped: saved.Skipped, failures: failures}
+		imp.savedFailures = len(failures)
+	}
+	if start > 0 {
+		logger.Info("resuming import",
+			"input", imp.input,
+			"after_line", start,
+			"imported", imp.summary.imported,
+			"skipped", imp.summary.skipped,
+			"failed", len(imp.summary.failures),
+		)
+	}
+
+	f, err := os.Open(imp.input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	began := time.Now()
+	ticker := time.NewTicker(imp.cfg.progress)
+	defer ticker.Stop()
+
+	done, sinceCheckpoint := start, 0
+	err = service.ScanJSONLines(f, func(line int, raw []byte) error {
+		if line <= start {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := imp.importLine(ctx, raw)
+		if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+			// The line was cut short; it is done again on resume.
+			return ctxErr
+		}
+		imp.summary.add(line, string(raw), err)
+		done = line
+
+		if sinceCheckpoint++; imp.cfg.checkpoint > 0 && sinceCheckpoint >= imp.cfg.checkpoint {
+			if err := imp.checkpoint(done); err != nil {
+				return err
+			}
+			sinceCheckpoint = 0
+		}
+		select {
+		case <-ticker.C:
+			logger.Info("import progress",
+				"line", done,
+				"imported", imp.summary.imported,
+				"skipped", imp.summary.skipped,
+				"failed", len(imp.summary.failures),
+				"lines_per_second", float64(done-start)/time.Since(began).Seconds(),
+			)
+		default:
+		}
+		return nil
+	})
+	if cerr := imp.checkpoint(done); cerr != nil && err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(imp.failuresPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return os.Remove(imp.checkpointPath)
+}
+
+// checkpoint records that every line up to line is done: it writes the
+// entries imported since the last checkpoint, appends the new failures to
+// the failures file, then writes the checkpoint itself.
+func (imp *importer) checkpoint(line int) error {
+	if err := imp.batch.flush(); err != nil {
+		return err
+	}
+	if err := appendFailures(imp.failuresPath, imp.summary.failures[imp.savedFailures:]); err != nil {
+		return err
+	}
+	imp.savedFailures = len(imp.summary.failures)
+	return writeCheckpoint(imp.checkpointPath, checkpoint{
+		Line:     line,
+		Imported: imp.summary.imported,
+		Skipped:  imp.summary.skipped,
+		Failed:   len(imp.summary.failures),
+	})
+}
+
+// checkpoint records that every line up to Line is done, with the outcome
+// of those lines. The failures themselves are in the failures file.
+type checkpoint struct {
+	Line     int `json:"line"`
+	Imported int `json:"imported"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+}
+
+// failureRecord is a line of the failures file.
+type failureRecord struct {
+	Line  int    `json:"line"`
+	Input string `json:"input"`
+	Error string `json:"error"`
+}
+
+// readCheckpoint returns the checkpoint at path, the zero checkpoint when
+// there is none.
+func readCheckpoint(path string) (checkpoint, error) {
+	var c checkpoint
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("checkpoint %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// writeCheckpoint writes c to path. It writes a temporary file first so a
+// crash never leaves a torn checkpoint.
+func writeCheckpoint(path string, c checkpoint) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// readFailures returns the first n failures of the failures file at path.
+// Records past them were appended by a run that stopped before its next
+// checkpoint; their lines are done again, so the file is cut back to n.
+func readFailures(path string, n int) ([]failure, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if n > 0 {
+			return nil, fmt.Errorf("failures %s: %w", path, err)
+		}
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	errStop := errors.New("stop")
+	var failures []failure
+	var size int64
+	err = service.ScanJSONLines(f, func(line int, raw []byte) error {
+		if len(failures) == n {
+			return errStop
+		}
+		var r failureRecord
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		failures = append(failures, failure{line: r.Line, input: r.Input, err: errors.New(r.Error)})
+		size += int64(len(raw)) + 1
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStop) {
+		return nil, fmt.Errorf("failures %s: %w", path, err)
+	}
+	if len(failures) < n {
+		return nil, fmt.Errorf("failures %s: %d records, checkpoint counts %d", path, len(failures), n)
+	}
+	if err := os.Truncate(path, size); err != nil {
+		return nil, err
+	}
+	return failures, nil
+}
+
+// appendFailures appends failures to the failures file at path.
+func appendFailures(path string, failures []failure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, f := range failures {
+		if err := enc.Encode(failureRecord{Line: f.line, Input: f.input, Error: f.err.Error()}); err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+type failure struct {
+	line  int
+	input string
+	err   error
+}
+
+// summary counts the outcome of every line imported.
+type summary struct {
+	imported int
+	skipped  int
+	failures []failure
+}
+
+func (s *summary) add(line int, input string, err error) {
+	switch {
+	case err == nil:
+		s.imported++
+	case errors.Is(err, errSkipped):
+		s.skipped++
+	default:
+		s.failures = append(s.failures, failure{line: line, input: input, err: err})
+	}
+}
+
+func (s *summary) log(logger *slog.Logger) {
+	reasons := make(map[string]int)
+	for _, f := range s.failures {
+		reasons[f.err.Error()]++
+	}
+	logger.Info("import summary",
+		"imported", s.imported,
+		"skipped", s.skipped,
+		"failed", len(s.failures),
+	)
+	for reason, n := range reasons {
+		logger.Warn("import failures", "reason", reason, "count", n)
+	}
+	for i, f := range s.failures {
+		if i == maxListedFailures {
+			logger.Warn("more failures not listed", "count", len(s.failures)-i)
+			break
+		}
+		logger.Warn("failed line", "line", f.line, "input", truncate(f.input, 80), "error", f.err)
+	}
+}
+
+// writeFailures writes the input of every failed line to path, one per line,
+// ready to be imported again.
+func (s *summary) writeFailures(path string) error {
+	var b strings.Builder
+	for _, f := range s.failures {
+		b.WriteString(f.input)
+		b.WriteByte('\n')
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
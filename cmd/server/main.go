@@ -0,0 +1,304 @@
+// Command server runs the WordService gRPC server and, optionally, its
+// HTTP/JSON gateway.
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/kakurineuin/learn-english-word/auth"
+	"github.com/kakurineuin/learn-english-word/autocomplete"
+	"github.com/kakurineuin/learn-english-word/cache"
+	"github.com/kakurineuin/learn-english-word/dictdb"
+	"github.com/kakurineuin/learn-english-word/gateway"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/search"
+	"github.com/kakurineuin/learn-english-word/service"
+	"github.com/kakurineuin/learn-english-word/store"
+	"github.com/kakurineuin/learn-english-word/suggest"
+)
+
+type config struct {
+	addr            string
+	httpAddr        string
+	tlsCert         string
+	tlsKey          string
+	jwtKeyFile      string
+	source          string
+	dictFile        string
+	dictDB          string
+	cache           cache.Config
+	cacheStatsEvery time.Duration
+	shutdownTimeout time.Duration
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.addr, "addr", ":50051", "address to listen on")
+	flag.StringVar(&cfg.httpAddr, "http-addr", "", "address the HTTP/JSON gateway listens on; not served when empty")
+	flag.StringVar(&cfg.tlsCert, "tls-cert", "", "TLS certificate file; serves plaintext when empty")
+	flag.StringVar(&cfg.tlsKey, "tls-key", "", "TLS private key file")
+	flag.StringVar(&cfg.jwtKeyFile, "jwt-key-file", "", "file holding the HS256 key of bearer tokens; calls are not authenticated when empty")
+	flag.StringVar(&cfg.source, "source", "file", "dictionary backend: file, or memory to serve only the words kept in -dict-db")
+	flag.StringVar(&cfg.dictFile, "dict-file", "", "JSON or JSONL dump of word meanings read by the file backend")
+	flag.StringVar(&cfg.dictDB, "dict-db", "", "file that keeps every word resolved by the source, the history of dictionary edits and user data; user data is lost on exit when empty")
+	flag.IntVar(&cfg.cache.Size, "cache-size", cache.DefaultSize, "number of words whose lookups are cached; 0 disables the cache")
+	flag.DurationVar(&cfg.cache.TTL, "cache-ttl", cache.DefaultTTL, "how long a cached lookup is kept")
+	flag.DurationVar(&cfg.cache.NegativeTTL, "cache-negative-ttl", cache.DefaultNegativeTTL, "how long a word not found is remembered")
+	flag.DurationVar(&cfg.cacheStatsEvery, "cache-stats-every", time.Minute, "how often to log lookup cache statistics; 0 logs them at shutdown only")
+	flag.DurationVar(&cfg.shutdownTimeout, "shutdown-timeout", 10*time.Second, "how long to wait for in-flight RPCs on shutdown")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	if err := run(cfg, logger); err != nil {
+		logger.Error("server stopped", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg config, logger *slog.Logger) error {
+	source, err := newSource(cfg)
+	if err != nil {
+		return err
+	}
+	var changes service.WordMeaningChangeStore
+	var userData service.Store
+	if cfg.dictDB != "" {
+		db, err := dictdb.Open(cfg.dictDB)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		source = service.NewPersistentSource(source, db)
+		changes = store.NewChangeLog(db)
+		if userData, err = store.NewPersistent(db); err != nil {
+			return fmt.Errorf("load user data: %w", err)
+		}
+	}
+	suggestions := suggest.NewIndex()
+	completions := autocomplete.NewIndex()
+	definitions := search.NewIndex()
+	err = indexDictionary(context.Background(), source, func(wordMeaning *pb.WordMeaning) {
+		suggestions.Add(wordMeaning.GetWord())
+		completions.Add(wordMeaning.GetWord(), wordMeaning.GetPartOfSpeech())
+		definitions.Add(wordMeaning)
+	})
+	if err != nil {
+		return err
+	}
+
+	var opts []grpc.ServerOption
+	if cfg.tlsCert != "" || cfg.tlsKey != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.tlsCert, cfg.tlsKey)
+		if err != nil {
+			return fmt.Errorf("load TLS credentials: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	var authenticator *auth.Authenticator
+	if cfg.jwtKeyFile != "" {
+		key, err := os.ReadFile(cfg.jwtKeyFile)
+		if err != nil {
+			return fmt.Errorf("read JWT key: %w", err)
+		}
+		key = bytes.TrimSpace(key)
+		if len(key) == 0 {
+			return fmt.Errorf("JWT key file %s is empty", cfg.jwtKeyFile)
+		}
+		authenticator = auth.New(key, service.MethodPermission)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamInterceptor()),
+		)
+	}
+
+	serviceOpts := []service.Option{
+		service.WithLogger(logger),
+		service.WithSuggestions(suggestions),
+		service.WithAutocomplete(completions),
+		service.WithSearch(definitions),
+	}
+	if changes != nil {
+		serviceOpts = append(serviceOpts, service.WithChangeStore(changes))
+	}
+	if userData != nil {
+		serviceOpts = append(serviceOpts, service.WithStore(userData))
+	}
+	var lookups *cache.Cache
+	if cfg.cache.Size > 0 {
+		lookups = cache.New(cfg.cache)
+		serviceOpts = append(serviceOpts, service.WithCache(lookups))
+	}
+
+	wordService := service.New(source, serviceOpts...)
+	server := grpc.NewServer(opts...)
+	pb.RegisterWordServiceServer(server, wordService)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	lis, err := net.Listen("tcp", cfg.addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", cfg.addr, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var httpServer *http.Server
+	var httpLis net.Listener
+	if cfg.httpAddr != "" {
+		gatewayOpts := []gateway.Option{gateway.WithLogger(logger)}
+		if authenticator != nil {
+			gatewayOpts = append(gatewayOpts, gateway.WithAuthenticator(authenticator))
+		}
+		httpServer = &http.Server{
+			Handler:           gateway.New(wordService, gatewayOpts...),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		httpLis, err = net.Listen("tcp", cfg.httpAddr)
+		if err != nil {
+			lis.Close()
+			return fmt.Errorf("listen on %s: %w", cfg.httpAddr, err)
+		}
+	}
+
+	if lookups != nil && cfg.cacheStatsEvery > 0 {
+		go logCacheStats(ctx, logger, lookups, cfg.cacheStatsEvery)
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Info("serving WordService",
+			"addr", lis.Addr().String(),
+			"tls", cfg.tlsCert != "",
+			"auth", cfg.jwtKeyFile != "",
+		)
+		serveErr <- server.Serve(lis)
+	}()
+	httpServeErr := make(chan error, 1)
+	if httpServer != nil {
+		go func() {
+			logger.Info("serving HTTP/JSON gateway", "addr", httpLis.Addr().String())
+			if cfg.tlsCert != "" {
+				httpServeErr <- httpServer.ServeTLS(httpLis, cfg.tlsCert, cfg.tlsKey)
+			} else {
+				httpServeErr <- httpServer.Serve(httpLis)
+			}
+		}()
+	}
+
+	var stopErr error
+	select {
+	case err := <-serveErr:
+		return err
+	case err := <-httpServeErr:
+		stopErr = fmt.Errorf("HTTP/JSON gateway: %w", err)
+	case <-ctx.Done():
+	}
+
+	logger.Info("shutting down")
+	healthServer.Shutdown()
+	if httpServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.shutdownTimeout)
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			httpServer.Close()
+		}
+		cancel()
+	}
+	gracefulStop(server, cfg.shutdownTimeout)
+	if lookups != nil {
+		logger.Info("lookup cache", "stats", lookups.Stats())
+	}
+
+	if err := <-serveErr; err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+	return stopErr
+}
+
+// logCacheStats logs the statistics of the lookup cache every interval until
+// ctx is done, so hit rates can be followed on a running server.
+func logCacheStats(ctx context.Context, logger *slog.Logger, lookups *cache.Cache, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			logger.Info("lookup cache", "stats", lookups.Stats())
+		}
+	}
+}
+
+// gracefulStop waits for in-flight RPCs to finish, forcing the server to stop
+// once timeout elapses.
+func gracefulStop(server *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		server.Stop()
+		<-done
+	}
+}
+
+// indexDictionary feeds every entry of source to add, if source can enumerate
+// its entries.
+func indexDictionary(
+	ctx context.Context,
+	source service.DictionarySource,
+	add func(*pb.WordMeaning),
+) error {
+	walker, ok := source.(service.DictionaryWalker)
+	if !ok {
+		return nil
+	}
+	err := walker.WalkWordMeanings(ctx, func(wordMeaning *pb.WordMeaning) error {
+		add(wordMeaning)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("index dictionary: %w", err)
+	}
+	return nil
+}
+
+func newSource(cfg config) (service.DictionarySource, error) {
+	switch cfg.source {
+	case "memory":
+		// An empty dictionary, so it takes the words of -dict-db to serve any.
+		if cfg.dictDB == "" {
+			return nil, errors.New("-dict-db is required by the memory source, which is empty")
+		}
+		return service.NewMemorySource(), nil
+	case "file":
+		if cfg.dictFile == "" {
+			return nil, errors.New("-dict-file is required by the file source")
+		}
+		return service.LoadFileSource(cfg.dictFile)
+	default:
+		return nil, fmt.Errorf("unknown dictionary source %q", cfg.source)
+	}
+}
@@ -0,0 +1,367 @@
+// Package dictdb keeps dictionary entries in an embedded key-value file, so
+// a word resolved from an upstream source once never has to be fetched
+// again.
+//
+// Entries are keyed by headword. Each holds the complete WordMeaning messages
+// of the headword, query_by_words included, in protobuf wire format. An index
+// maps the id of every word meaning to its headword. The history of the
+// edits of the entries is kept alongside them, and so are the records of
+// user data, in forms chosen by the caller.
+package dictdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// ErrSchemaTooNew is returned by Open for a file written by a newer version
+// of this package.
+var ErrSchemaTooNew = errors.New("dictdb: schema is newer than supported")
+
+var (
+	metaBucket    = []byte("meta")
+	wordsBucket   = []byte("words")
+	idsBucket     = []byte("ids")
+	changesBucket = []byte("changes")
+	recordsBucket = []byte("records")
+
+	schemaVersionKey = []byte("schema_version")
+)
+
+// DB is a dictionary file. It is safe for concurrent use; a file can be open
+// in one process at a time.
+type DB struct {
+	db *bolt.DB
+}
+
+// Open opens the dictionary file at path, creating it if needed, and brings
+// its schema up to date.
+func Open(path string) (*DB, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	if err := db.Update(migrate); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate %s: %w", path, err)
+	}
+	return &DB{db: db}, nil
+}
+
+// Close closes the file.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// SchemaVersion returns the schema version of the file.
+func (d *DB) SchemaVersion() (int, error) {
+	var version int
+	err := d.db.View(func(tx *bolt.Tx) error {
+		var err error
+		version, err = schemaVersion(tx)
+		return err
+	})
+	return version, err
+}
+
+// Get returns the word meanings stored under headword, sorted by order_by_no,
+// and whether there is an entry for it.
+func (d *DB) Get(headword string) ([]*pb.WordMeaning, bool, error) {
+	var wordMeanings []*pb.WordMeaning
+	var found bool
+	err := d.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(wordsBucket).Get([]byte(headword))
+		if value == nil {
+			return nil
+		}
+		found = true
+		var err error
+		wordMeanings, err = decode(value)
+		return err
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("get %q: %w", headword, err)
+	}
+	return wordMeanings, found, nil
+}
+
+// Headword returns the headword of the entry holding the word meaning id,
+// and whether there is one.
+func (d *DB) Headword(id string) (string, bool, error) {
+	var headword []byte
+	err := d.db.View(func(tx *bolt.Tx) error {
+		headword = bytes.Clone(tx.Bucket(idsBucket).Get([]byte(id)))
+		return nil
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("headword of %q: %w", id, err)
+	}
+	return string(headword), headword != nil, nil
+}
+
+// Put replaces the entry of headword with wordMeanings. An entry without
+// meanings records that the headword has none, for instance because they
+// were all deleted.
+func (d *DB) Put(headword string, wordMeanings []*pb.WordMeaning) error {
+	value, err := encode(wordMeanings)
+	if err != nil {
+		return fmt.Errorf("put %q: %w", headword, err)
+	}
+	err = d.db.Update(func(tx *bolt.Tx) error {
+		return put(tx, headword, wordMeanings, value)
+	})
+	if err != nil {
+		return fmt.Errorf("put %q: %w", headword, err)
+	}
+	return nil
+}
+
+// PutAll replaces the entries of several headwords, as Put does for one, in
+// a single transaction.
+func (d *DB) PutAll(entries map[string][]*pb.WordMeaning) error {
+	values := make(map[string][]byte, len(entries))
+	for headword, wordMeanings := range entries {
+		value, err := encode(wordMeanings)
+		if err != nil {
+			return fmt.Errorf("put %q: %w", headword, err)
+		}
+		values[headword] = value
+	}
+	return d.db.Update(func(tx *bolt.Tx) error {
+		for headword, value := range values {
+			if err := put(tx, headword, entries[headword], value); err != nil {
+				return fmt.Errorf("put %q: %w", headword, err)
+			}
+		}
+		return nil
+	})
+}
+
+// PutIfAbsent stores wordMeanings as the entry of headword unless there is
+// one already, and returns the entry the file holds afterwards. The check
+// and the write are one transaction, so an entry written meanwhile, such as
+// an edit, is never overwritten.
+func (d *DB) PutIfAbsent(headword string, wordMeanings []*pb.WordMeaning) ([]*pb.WordMeaning, error) {
+	value, err := encode(wordMeanings)
+	if err != nil {
+		return nil, fmt.Errorf("put %q: %w", headword, err)
+	}
+	stored := wordMeanings
+	err = d.db.Update(func(tx *bolt.Tx) error {
+		if existing := tx.Bucket(wordsBucket).Get([]byte(headword)); existing != nil {
+			var err error
+			stored, err = decode(existing)
+			return err
+		}
+		return put(tx, headword, wordMeanings, value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("put %q: %w", headword, err)
+	}
+	return stored, nil
+}
+
+// put writes value, the encoding of wordMeanings, as the entry of headword
+// and indexes its ids.
+func put(tx *bolt.Tx, headword string, wordMeanings []*pb.WordMeaning, value []byte) error {
+	if err := unindex(tx, headword); err != nil {
+		return err
+	}
+	ids := tx.Bucket(idsBucket)
+	for _, wordMeaning := range wordMeanings {
+		if wordMeaning.GetId() == "" {
+			continue
+		}
+		if err := ids.Put([]byte(wordMeaning.GetId()), []byte(headword)); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(wordsBucket).Put([]byte(headword), value)
+}
+
+// Delete removes the entry of headword, if any.
+func (d *DB) Delete(headword string) error {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		if err := unindex(tx, headword); err != nil {
+			return err
+		}
+		return tx.Bucket(wordsBucket).Delete([]byte(headword))
+	})
+	if err != nil {
+		return fmt.Errorf("delete %q: %w", headword, err)
+	}
+	return nil
+}
+
+// unindex removes the ids of the current entry of headword from the index.
+func unindex(tx *bolt.Tx, headword string) error {
+	value := tx.Bucket(wordsBucket).Get([]byte(headword))
+	if value == nil {
+		return nil
+	}
+	wordMeanings, err := decode(value)
+	if err != nil {
+		return err
+	}
+	ids := tx.Bucket(idsBucket)
+	for _, wordMeaning := range wordMeanings {
+		if wordMeaning.GetId() == "" || string(ids.Get([]byte(wordMeaning.GetId()))) != headword {
+			continue
+		}
+		if err := ids.Delete([]byte(wordMeaning.GetId())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Walk calls fn with every entry in headword order, stopping at the first
+// error fn returns. The database cannot be written until Walk returns.
+func (d *DB) Walk(fn func(headword string, wordMeanings []*pb.WordMeaning) error) error {
+	return d.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(wordsBucket).ForEach(func(k, v []byte) error {
+			wordMeanings, err := decode(v)
+			if err != nil {
+				return fmt.Errorf("entry %q: %w", k, err)
+			}
+			return fn(string(k), wordMeanings)
+		})
+	})
+}
+
+// PutChange stores value as the change id, replacing any change with that
+// id.
+func (d *DB) PutChange(id string, value []byte) error {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(changesBucket).Put([]byte(id), value)
+	})
+	if err != nil {
+		return fmt.Errorf("put change %q: %w", id, err)
+	}
+	return nil
+}
+
+// Change returns the change id and whether there is one.
+func (d *DB) Change(id string) ([]byte, bool, error) {
+	var value []byte
+	err := d.db.View(func(tx *bolt.Tx) error {
+		value = bytes.Clone(tx.Bucket(changesBucket).Get([]byte(id)))
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("get change %q: %w", id, err)
+	}
+	return value, value != nil, nil
+}
+
+// WalkChanges calls fn with every change in id order, stopping at the first
+// error fn returns. value is only valid until fn returns, and the database
+// cannot be written until WalkChanges returns.
+func (d *DB) WalkChanges(fn func(id string, value []byte) error) error {
+	return d.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(changesBucket).ForEach(func(k, v []byte) error {
+			return fn(string(k), v)
+		})
+	})
+}
+
+// encode writes wordMeanings the way a message holding them as
+// `repeated WordMeaning word_meanings = 1` would be.
+func encode(wordMeanings []*pb.WordMeaning) ([]byte, error) {
+	b := []byte{}
+	for _, wordMeaning := range wordMeanings {
+		raw, err := proto.Marshal(wordMeaning)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendBytes(b, raw)
+	}
+	return b, nil
+}
+
+func decode(b []byte) ([]*pb.WordMeaning, error) {
+	var wordMeanings []*pb.WordMeaning
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		if num != 1 || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+		raw, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		wordMeaning := &pb.WordMeaning{}
+		if err := proto.Unmarshal(raw, wordMeaning); err != nil {
+			return nil, err
+		}
+		wordMeanings = append(wordMeanings, wordMeaning)
+	}
+	sort.SliceStable(wordMeanings, func(i, j int) bool {
+		return wordMeanings[i].GetOrderByNo() < wordMeanings[j].GetOrderByNo()
+	})
+	return wordMeanings, nil
+}
+
+// Record is a user data record of some kind, written by WriteRecords. A
+// Record with a nil Value deletes the record.
+type Record struct {
+	Kind  string
+	ID    string
+	Value []byte
+}
+
+// WriteRecords stores, or deletes, records in one transaction.
+func (d *DB) WriteRecords(records ...Record) error {
+	return d.db.Update(func(tx *bolt.Tx) error {
+		for _, record := range records {
+			kind, err := tx.Bucket(recordsBucket).CreateBucketIfNotExists([]byte(record.Kind))
+			if err != nil {
+				return fmt.Errorf("records %q: %w", record.Kind, err)
+			}
+			if record.Value == nil {
+				err = kind.Delete([]byte(record.ID))
+			} else {
+				err = kind.Put([]byte(record.ID), record.Value)
+			}
+			if err != nil {
+				return fmt.Errorf("write %s %q: %w", record.Kind, record.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// WalkRecords calls fn with every record of kind in id order, stopping at
+// the first error fn returns. value is only valid until fn returns, and the
+// database cannot be written until WalkRecords returns.
+func (d *DB) WalkRecords(kind string, fn func(id string, value []byte) error) error {
+	return d.db.View(func(tx *bolt.Tx) error {
+		records := tx.Bucket(recordsBucket).Bucket([]byte(kind))
+		if records == nil {
+			return nil
+		}
+		return records.ForEach(func(k, v []byte) error {
+			return fn(string(k), v)
+		})
+	})
+}
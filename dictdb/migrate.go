@@ -0,0 +1,89 @@
+package dictdb
+
+import (
+	"fmt"
+	"strconv"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// migrations bring a file from one schema version to the next: migrations[i]
+// upgrades version i to version i+1. Once released a migration must not
+// change; a schema change is made by appending a new one.
+var migrations = []func(tx *bolt.Tx) error{
+	// 0 -> 1: buckets of the entries and of the file's own metadata.
+	func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(metaBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(wordsBucket)
+		return err
+	},
+	// 1 -> 2: index of the headword of every word meaning id.
+	func(tx *bolt.Tx) error {
+		ids, err := tx.CreateBucketIfNotExists(idsBucket)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(wordsBucket).ForEach(func(k, v []byte) error {
+			wordMeanings, err := decode(v)
+			if err != nil {
+				return fmt.Errorf("entry %q: %w", k, err)
+			}
+			for _, wordMeaning := range wordMeanings {
+				if wordMeaning.GetId() == "" {
+					continue
+				}
+				if err := ids.Put([]byte(wordMeaning.GetId()), k); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	},
+	// 2 -> 3: history of the edits of the entries.
+	func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(changesBucket)
+		return err
+	},
+	// 3 -> 4: user data, in a bucket per kind of record.
+	func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(recordsBucket)
+		return err
+	},
+}
+
+// migrate runs, in one transaction, the migrations the file has not seen
+// yet.
+func migrate(tx *bolt.Tx) error {
+	version, err := schemaVersion(tx)
+	if err != nil {
+		return err
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("%w: version %d", ErrSchemaTooNew, version)
+	}
+	for ; version < len(migrations); version++ {
+		if err := migrations[version](tx); err != nil {
+			return fmt.Errorf("migration to version %d: %w", version+1, err)
+		}
+	}
+	return tx.Bucket(metaBucket).Put(schemaVersionKey, []byte(strconv.Itoa(version)))
+}
+
+// schemaVersion returns the schema version of the file, 0 for a new file.
+func schemaVersion(tx *bolt.Tx) (int, error) {
+	meta := tx.Bucket(metaBucket)
+	if meta == nil {
+		return 0, nil
+	}
+	value := meta.Get(schemaVersionKey)
+	if value == nil {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(string(value))
+	if err != nil {
+		return 0, fmt.Errorf("schema version %q: %w", value, err)
+	}
+	return version, nil
+}
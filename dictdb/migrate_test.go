@@ -0,0 +1,160 @@
+package dictdb
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// writeFile creates a bbolt file at path holding what setup writes, as an
+// older version of the package would have left it.
+func writeFile(t *testing.T, path string, setup func(tx *bolt.Tx) error) {
+	t.Helper()
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := db.Update(setup); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// version1 writes a version 1 file: its metadata and its entries, with no
+// index of ids.
+func version1(entries map[string][]*pb.WordMeaning) func(tx *bolt.Tx) error {
+	return func(tx *bolt.Tx) error {
+		meta, err := tx.CreateBucket(metaBucket)
+		if err != nil {
+			return err
+		}
+		if err := meta.Put(schemaVersionKey, []byte("1")); err != nil {
+			return err
+		}
+		words, err := tx.CreateBucket(wordsBucket)
+		if err != nil {
+			return err
+		}
+		for headword, wordMeanings := range entries {
+			value, err := encode(wordMeanings)
+			if err != nil {
+				return err
+			}
+			if err := words.Put([]byte(headword), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	entries := map[string][]*pb.WordMeaning{
+		"run": {
+			{Id: "run-1", Word: "run", OrderByNo: 1},
+			{Id: "run-2", Word: "run", OrderByNo: 2},
+		},
+		"walk": {{Id: "walk-1", Word: "walk", OrderByNo: 1}},
+		// An entry recording that a headword has no meanings.
+		"xyzzy": {},
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(tx *bolt.Tx) error
+		entries map[string][]*pb.WordMeaning
+		wantErr error
+	}{
+		{
+			name:  "new file",
+			setup: func(*bolt.Tx) error { return nil },
+		},
+		{
+			name:    "version 1",
+			setup:   version1(entries),
+			entries: entries,
+		},
+		{
+			name: "newer version",
+			setup: func(tx *bolt.Tx) error {
+				meta, err := tx.CreateBucket(metaBucket)
+				if err != nil {
+					return err
+				}
+				return meta.Put(schemaVersionKey, []byte(strconv.Itoa(len(migrations)+1)))
+			},
+			wantErr: ErrSchemaTooNew,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "dict.db")
+			writeFile(t, path, tt.setup)
+
+			db, err := Open(path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer db.Close()
+
+			version, err := db.SchemaVersion()
+			if err != nil || version != len(migrations) {
+				t.Errorf("SchemaVersion() = %d, %v, want %d", version, err, len(migrations))
+			}
+			err = db.db.View(func(tx *bolt.Tx) error {
+				for _, bucket := range [][]byte{metaBucket, wordsBucket, idsBucket, changesBucket, recordsBucket} {
+					if tx.Bucket(bucket) == nil {
+						t.Errorf("bucket %s is missing", bucket)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for headword, wordMeanings := range tt.entries {
+				got, found, err := db.Get(headword)
+				if err != nil || !found || len(got) != len(wordMeanings) {
+					t.Errorf("Get(%q) = %d meanings, %v, %v, want %d", headword, len(got), found, err, len(wordMeanings))
+				}
+				for _, wordMeaning := range wordMeanings {
+					got, found, err := db.Headword(wordMeaning.GetId())
+					if err != nil || !found || got != headword {
+						t.Errorf("Headword(%q) = %q, %v, %v, want %q", wordMeaning.GetId(), got, found, err, headword)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMigrateOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dict.db")
+	writeFile(t, path, version1(map[string][]*pb.WordMeaning{
+		"run": {{Id: "run-1", Word: "run"}},
+	}))
+
+	// Reopening a migrated file leaves it as it is.
+	for i := 0; i < 2; i++ {
+		db, err := Open(path)
+		if err != nil {
+			t.Fatalf("open %d: %v", i+1, err)
+		}
+		if headword, found, err := db.Headword("run-1"); err != nil || !found || headword != "run" {
+			t.Errorf("open %d: Headword(run-1) = %q, %v, %v", i+1, headword, found, err)
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
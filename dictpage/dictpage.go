@@ -0,0 +1,315 @@
+// Package dictpage parses saved dictionary HTML pages into WordMeaning
+// messages.
+//
+// A page holds one or more entries, each a headword with its part of speech,
+// pronunciation and numbered senses:
+//
+//	<span class="ldoceEntry Entry">
+//	  <span class="Head">
+//	    <span class="HWD">run</span>
+//	    <span class="PRON">rʌn</span>
+//	    <span class="FREQ">S1</span> <span class="FREQ">W1</span>
+//	    <span class="POS">verb</span>
+//	    <span class="GRAM">[intransitive, transitive]</span>
+//	    <span class="speaker brefile" data-src-mp3="…/run_uk.mp3"></span>
+//	    <span class="speaker amefile" data-src-mp3="…/run_us.mp3"></span>
+//	  </span>
+//	  <span class="Sense">
+//	    <span class="GRAM">[intransitive]</span>
+//	    <span class="DEF">to move very quickly by moving your legs</span>
+//	    <span class="EXAMPLE">
+//	      <span class="speaker exafile" data-src-mp3="…/ex1.mp3"></span>
+//	      She ran to the window.
+//	    </span>
+//	    <span class="GramExa">
+//	      <span class="PROPFORM">run across/down/up etc</span>
+//	      <span class="EXAMPLE">He ran up the stairs.</span>
+//	    </span>
+//	  </span>
+//	</span>
+//
+// Every sense, or every subsense of a sense split into Subsense elements,
+// becomes one WordMeaning. Senses without a definition, such as cross
+// references, are left out.
+package dictpage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/net/html"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+// ErrNoEntries is returned for a page without any dictionary entry, such as
+// a "word not found" page.
+var ErrNoEntries = errors.New("dictpage: no dictionary entries")
+
+// ParseFile parses the saved page at path.
+func ParseFile(path string) ([]*pb.WordMeaning, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse returns the word meanings of the page read from r in page order. Each
+// gets an order_by_no counting from 1 across the whole page and the stable id
+// derived from its headword, part of speech and order_by_no.
+func Parse(r io.Reader) ([]*pb.WordMeaning, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := findAll(doc, "ldoceEntry")
+	if len(entries) == 0 {
+		return nil, ErrNoEntries
+	}
+
+	var wordMeanings []*pb.WordMeaning
+	for _, entry := range entries {
+		head := find(entry, "Head")
+		if head == nil {
+			continue
+		}
+		word := text(find(head, "HWD"))
+		if word == "" {
+			continue
+		}
+		partOfSpeech := text(find(head, "POS"))
+		gram := text(childOf(head, "GRAM"))
+		pronunciation := &pb.Pronunciation{
+			Text:       text(find(head, "PRON")),
+			UkAudioUrl: audioURL(find(head, "brefile")),
+			UsAudioUrl: audioURL(find(head, "amefile")),
+		}
+		if proto.Size(pronunciation) == 0 {
+			pronunciation = nil
+		}
+		frequency := frequency(findAll(head, "FREQ"))
+
+		for _, sense := range findAll(entry, "Sense") {
+			signpostGram := text(childOf(sense, "GRAM"))
+			parts := findAll(sense, "Subsense")
+			if len(parts) == 0 {
+				parts = []*html.Node{sense}
+			}
+			for _, part := range parts {
+				definition := text(childOf(part, "DEF"))
+				if definition == "" {
+					continue
+				}
+				defGram := text(childOf(part, "GRAM"))
+				if defGram == "" {
+					defGram = signpostGram
+				}
+				orderByNo := int32(len(wordMeanings) + 1)
+				wordMeanings = append(wordMeanings, &pb.WordMeaning{
+					Id:            store.WordMeaningID(strings.ToLower(word), partOfSpeech, orderByNo),
+					Word:          strings.ToLower(word),
+					PartOfSpeech:  partOfSpeech,
+					Gram:          gram,
+					Pronunciation: clonePronunciation(pronunciation),
+					DefGram:       defGram,
+					Definition:    definition,
+					Examples:      examples(part),
+					OrderByNo:     orderByNo,
+					Frequency:     frequency,
+				})
+			}
+		}
+	}
+	if len(wordMeanings) == 0 {
+		return nil, ErrNoEntries
+	}
+	return wordMeanings, nil
+}
+
+// examples collects the example sentences of a sense. Sentences given
+// directly under the sense share one Example without a pattern; those under
+// a grammar pattern or collocation are grouped by it.
+func examples(sense *html.Node) []*pb.Example {
+	var found []*pb.Example
+	var plain *pb.Example
+	for c := sense.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case hasClass(c, "EXAMPLE"):
+			if plain == nil {
+				plain = &pb.Example{}
+				found = append(found, plain)
+			}
+			plain.Examples = append(plain.Examples, sentence(c))
+		case hasClass(c, "GramExa"), hasClass(c, "ColloExa"):
+			pattern := text(find(c, "PROPFORM"))
+			if pattern == "" {
+				pattern = text(find(c, "PROPFORMPREP"))
+			}
+			if pattern == "" {
+				pattern = text(find(c, "COLLO"))
+			}
+			example := &pb.Example{Pattern: pattern}
+			for _, e := range findAll(c, "EXAMPLE") {
+				example.Examples = append(example.Examples, sentence(e))
+			}
+			found = append(found, example)
+		}
+	}
+	return found
+}
+
+func clonePronunciation(pronunciation *pb.Pronunciation) *pb.Pronunciation {
+	if pronunciation == nil {
+		return nil
+	}
+	return proto.Clone(pronunciation).(*pb.Pronunciation)
+}
+
+func sentence(n *html.Node) *pb.Sentence {
+	return &pb.Sentence{
+		AudioUrl: audioURL(find(n, "exafile")),
+		Text:     text(n),
+	}
+}
+
+// frequency scores the spoken (S1-S3) and written (W1-W3) frequency bands of
+// an entry: 3 for the top band down to 1 for the third, summed. Pages band
+// entries, not senses, so every sense of an entry gets the same score.
+func frequency(bands []*html.Node) int32 {
+	var score int32
+	for _, band := range bands {
+		switch strings.TrimSpace(text(band)) {
+		case "S1", "W1":
+			score += 3
+		case "S2", "W2":
+			score += 2
+		case "S3", "W3":
+			score++
+		}
+	}
+	return score
+}
+
+func audioURL(n *html.Node) string {
+	if n == nil {
+		return ""
+	}
+	return attr(n, "data-src-mp3")
+}
+
+// text returns the whitespace-collapsed text of n, leaving out audio
+// buttons.
+func text(n *html.Node) string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+			b.WriteByte(' ')
+			return
+		}
+		if hasClass(n, "speaker") {
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+
+	s := strings.Join(strings.Fields(b.String()), " ")
+	// Inline elements split text at punctuation, e.g. "<span>run</span>,".
+	for _, p := range []string{",", ".", ";", ":", "?", "!", ")", "]"} {
+		s = strings.ReplaceAll(s, " "+p, p)
+	}
+	for _, p := range []string{"(", "["} {
+		s = strings.ReplaceAll(s, p+" ", p)
+	}
+	return s
+}
+
+// find returns the first element under n, n excluded, with class.
+func find(n *html.Node, class string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if hasClass(c, class) {
+			return c
+		}
+		if found := find(c, class); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findAll returns the outermost elements under n with class, without looking
+// inside the ones it finds.
+func findAll(n *html.Node, class string) []*html.Node {
+	var found []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if hasClass(c, class) {
+			found = append(found, c)
+			continue
+		}
+		found = append(found, findAll(c, class)...)
+	}
+	return found
+}
+
+// childOf returns the first element with class under n that is not inside a
+// nested sense or example, so a sense's own GRAM or DEF is not confused with
+// one of its subsenses or examples.
+func childOf(n *html.Node, class string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if hasClass(c, class) {
+			return c
+		}
+		if c.Type != html.ElementNode || nested(c) {
+			continue
+		}
+		if found := childOf(c, class); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func nested(n *html.Node) bool {
+	for _, class := range []string{"Subsense", "EXAMPLE", "GramExa", "ColloExa"} {
+		if hasClass(n, class) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasClass(n *html.Node, class string) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
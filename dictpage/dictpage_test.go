@@ -0,0 +1,134 @@
+package dictpage
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+func TestParseFile(t *testing.T) {
+	verbPronunciation := &pb.Pronunciation{
+		Text:       "rʌn",
+		UkAudioUrl: "https://example.com/media/run_uk.mp3",
+		UsAudioUrl: "https://example.com/media/run_us.mp3",
+	}
+	nounPronunciation := &pb.Pronunciation{
+		Text:       "rʌn",
+		UkAudioUrl: "https://example.com/media/run_n_uk.mp3",
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		want    []*pb.WordMeaning
+		wantErr error
+	}{
+		{
+			name: "entries, subsenses and example patterns",
+			file: "run.html",
+			want: []*pb.WordMeaning{
+				{
+					Id:            store.WordMeaningID("run", "verb", 1),
+					Word:          "run",
+					PartOfSpeech:  "verb",
+					Gram:          "[intransitive, transitive]",
+					Pronunciation: verbPronunciation,
+					DefGram:       "[intransitive]",
+					Definition:    "to move very quickly, by moving your legs more quickly than when you walk",
+					Examples: []*pb.Example{
+						{Examples: []*pb.Sentence{
+							{AudioUrl: "https://example.com/media/run_ex1.mp3", Text: "She ran to the window."},
+							{AudioUrl: "https://example.com/media/run_ex2.mp3", Text: "Can you run as fast as Tom?"},
+						}},
+						{Pattern: "run across/down/up etc", Examples: []*pb.Sentence{
+							{AudioUrl: "https://example.com/media/run_ex3.mp3", Text: "He ran up the stairs."},
+							{Text: "A little girl ran across the road."},
+						}},
+					},
+					OrderByNo: 1,
+					Frequency: 6,
+				},
+				{
+					Id:            store.WordMeaningID("run", "verb", 2),
+					Word:          "run",
+					PartOfSpeech:  "verb",
+					Gram:          "[intransitive, transitive]",
+					Pronunciation: verbPronunciation,
+					DefGram:       "[transitive]",
+					Definition:    "to organize or be in charge of an activity, business, organization, or country",
+					Examples: []*pb.Example{
+						{Pattern: "run a business", Examples: []*pb.Sentence{
+							{Text: "They run a small hotel."},
+						}},
+					},
+					OrderByNo: 2,
+					Frequency: 6,
+				},
+				{
+					Id:            store.WordMeaningID("run", "noun", 3),
+					Word:          "run",
+					PartOfSpeech:  "noun",
+					Pronunciation: nounPronunciation,
+					DefGram:       "[countable]",
+					Definition:    "a period of time spent running, or a distance that you run",
+					Examples: []*pb.Example{
+						{Examples: []*pb.Sentence{
+							{Text: "I usually go for a run before breakfast."},
+						}},
+					},
+					OrderByNo: 3,
+					Frequency: 2,
+				},
+				{
+					Id:            store.WordMeaningID("run", "noun", 4),
+					Word:          "run",
+					PartOfSpeech:  "noun",
+					Pronunciation: nounPronunciation,
+					DefGram:       "[singular]",
+					Definition:    "a very fast speed that is slower than a sprint",
+					Examples: []*pb.Example{
+						{Pattern: "at a run", Examples: []*pb.Sentence{
+							{Text: "She set off at a run."},
+						}},
+					},
+					OrderByNo: 4,
+					Frequency: 2,
+				},
+			},
+		},
+		{
+			name:    "not found page",
+			file:    "not_found.html",
+			wantErr: ErrNoEntries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFile(filepath.Join("testdata", tt.file))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseFile() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseFile() returned %d word meanings, want %d", len(got), len(tt.want))
+			}
+			ids := make(map[string]bool)
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("word meaning %d:\ngot  %v\nwant %v", i,
+						prototext.Format(got[i]), prototext.Format(tt.want[i]))
+				}
+				if ids[got[i].GetId()] {
+					t.Errorf("word meaning %d: id %q is not unique", i, got[i].GetId())
+				}
+				ids[got[i].GetId()] = true
+			}
+		})
+	}
+}
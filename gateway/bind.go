@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// bindQuery sets the fields of req named by the query parameters.
+func bindQuery(req proto.Message, query url.Values) error {
+	for name, values := range query {
+		if err := bindField(req, name, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindField sets the field of req called name, in its proto or JSON form,
+// to values. Only scalar and enum fields, repeated or not, can be bound.
+func bindField(req proto.Message, name string, values []string) error {
+	m := req.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	field := fields.ByName(protoreflect.Name(name))
+	if field == nil {
+		field = fields.ByJSONName(name)
+	}
+	if field == nil {
+		return status.Errorf(codes.InvalidArgument, "unknown parameter %q", name)
+	}
+	if field.IsMap() || field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		return status.Errorf(codes.InvalidArgument, "parameter %q must be sent in the body", name)
+	}
+
+	if !field.IsList() {
+		if len(values) != 1 {
+			return status.Errorf(codes.InvalidArgument, "parameter %q must be given once", name)
+		}
+		value, err := parseValue(field, values[0])
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "parameter %q: %v", name, err)
+		}
+		m.Set(field, value)
+		return nil
+	}
+
+	list := m.Mutable(field).List()
+	for _, raw := range values {
+		value, err := parseValue(field, raw)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "parameter %q: %v", name, err)
+		}
+		list.Append(value)
+	}
+	return nil
+}
+
+// parseValue parses raw as a value of a scalar or enum field. An enum value
+// is given by name or by number.
+func parseValue(field protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(raw), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(raw)), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(raw)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(raw, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(raw, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(raw, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(raw, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.EnumKind:
+		if value := field.Enum().Values().ByName(protoreflect.Name(raw)); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), nil
+		}
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown %s %q", field.Enum().Name(), raw)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", field.Kind())
+	}
+}
@@ -0,0 +1,284 @@
+// Package gateway serves the WordService as JSON over plain HTTP, for clients
+// that cannot speak gRPC. Requests and responses are the protojson form of
+// the service's messages; errors are gRPC statuses in the same form, sent
+// with the HTTP status matching their code.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/auth"
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// maxBodySize caps the size of a request body.
+const maxBodySize = 1 << 20
+
+// Gateway is an http.Handler calling a pb.WordServiceServer.
+type Gateway struct {
+	server        pb.WordServiceServer
+	authenticator *auth.Authenticator
+	logger        *slog.Logger
+	routes        []route
+}
+
+// Option configures a Gateway.
+type Option func(*Gateway)
+
+// WithAuthenticator makes the gateway authorize calls as the gRPC server
+// does, from the bearer token of the Authorization header.
+func WithAuthenticator(authenticator *auth.Authenticator) Option {
+	return func(g *Gateway) {
+		g.authenticator = authenticator
+	}
+}
+
+// WithLogger sets the logger used to report failures to write responses.
+func WithLogger(logger *slog.Logger) Option {
+	return func(g *Gateway) {
+		g.logger = logger
+	}
+}
+
+// New returns a Gateway in front of server. Path segments in braces are bound
+// to the request field of that name, as are query parameters; POST and PUT
+// requests take the rest of the request message as their body.
+func New(server pb.WordServiceServer, opts ...Option) *Gateway {
+	g := &Gateway{
+		server: server,
+		logger: slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	g.handle(http.MethodGet, "/words/{word}", unary("FindWordByDictionary", server.FindWordByDictionary))
+
+	g.handle(http.MethodGet, "/favorites", unary("FindFavoriteWordMeanings", server.FindFavoriteWordMeanings))
+	g.handle(http.MethodPost, "/favorites", unary("CreateFavoriteWordMeaning", server.CreateFavoriteWordMeaning))
+	g.handle(http.MethodDelete, "/favorites/{favorite_word_meaning_id}", unary("DeleteFavoriteWordMeaning", server.DeleteFavoriteWordMeaning))
+
+	g.handle(http.MethodGet, "/exams", unary("FindExams", server.FindExams))
+	g.handle(http.MethodPost, "/exams", unary("CreateExam", server.CreateExam))
+	g.handle(http.MethodPut, "/exams/{exam_id}", unary("UpdateExam", server.UpdateExam))
+	g.handle(http.MethodDelete, "/exams/{exam_id}", unary("DeleteExam", server.DeleteExam))
+	g.handle(http.MethodGet, "/exams/{exam_id}/questions", unary("FindQuestions", server.FindQuestions))
+	g.handle(http.MethodPost, "/exams/{exam_id}/questions", unary("CreateQuestion", server.CreateQuestion))
+	g.handle(http.MethodPut, "/questions/{question_id}", unary("UpdateQuestion", server.UpdateQuestion))
+	g.handle(http.MethodDelete, "/questions/{question_id}", unary("DeleteQuestion", server.DeleteQuestion))
+	g.handle(http.MethodGet, "/exams/{exam_id}/records", unary("FindExamRecords", server.FindExamRecords))
+	g.handle(http.MethodPost, "/exams/{exam_id}/records", unary("CreateExamRecord", server.CreateExamRecord))
+
+	return g
+}
+
+// rpc is a WordService method taking its request from an HTTP request.
+type rpc struct {
+	name string
+	// newRequest returns an empty request message.
+	newRequest func() proto.Message
+	call       func(ctx context.Context, req proto.Message) (proto.Message, error)
+}
+
+// unary returns the rpc of the unary method name, called through call.
+func unary[Req, Resp proto.Message](
+	name string,
+	call func(context.Context, Req) (Resp, error),
+) rpc {
+	return rpc{
+		name: name,
+		newRequest: func() proto.Message {
+			var req Req
+			return req.ProtoReflect().Type().New().Interface()
+		},
+		call: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return call(ctx, req.(Req))
+		},
+	}
+}
+
+type route struct {
+	method   string
+	segments []string
+	rpc      rpc
+}
+
+func (g *Gateway) handle(method, pattern string, rpc rpc) {
+	g.routes = append(g.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		rpc:      rpc,
+	})
+}
+
+// match returns the path parameters of path if it matches the route.
+func (r route) match(path []string) (map[string]string, bool) {
+	if len(path) != len(r.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, segment := range r.segments {
+		if name, ok := strings.CutPrefix(segment, "{"); ok {
+			if path[i] == "" {
+				return nil, false
+			}
+			params[strings.TrimSuffix(name, "}")] = path[i]
+		} else if segment != path[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var allowed []string
+	for _, route := range g.routes {
+		params, ok := route.match(path)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			allowed = append(allowed, route.method)
+			continue
+		}
+		g.serve(w, r, route.rpc, params)
+		return
+	}
+
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		g.writeStatus(w, r, http.StatusMethodNotAllowed,
+			status.Newf(codes.Unimplemented, "%s is not supported on %s", r.Method, r.URL.Path))
+		return
+	}
+	g.writeError(w, r, status.Errorf(codes.NotFound, "no endpoint at %s", r.URL.Path))
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request, rpc rpc, params map[string]string) {
+	req := rpc.newRequest()
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := readBody(w, r, req); err != nil {
+			g.writeError(w, r, err)
+			return
+		}
+	}
+	if err := bindQuery(req, r.URL.Query()); err != nil {
+		g.writeError(w, r, err)
+		return
+	}
+	for name, value := range params {
+		if err := bindField(req, name, []string{value}); err != nil {
+			g.writeError(w, r, err)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+	if g.authenticator != nil {
+		identity, err := g.authenticator.Authorize(ctx, "/"+pb.WordService_ServiceDesc.ServiceName+"/"+rpc.name)
+		if err != nil {
+			g.writeError(w, r, err)
+			return
+		}
+		ctx = auth.NewContext(ctx, identity)
+	}
+
+	resp, err := rpc.call(ctx, req)
+	if err != nil {
+		g.writeError(w, r, err)
+		return
+	}
+	g.write(w, r, http.StatusOK, resp)
+}
+
+// readBody decodes the JSON body of r, if any, into req.
+func readBody(w http.ResponseWriter, r *http.Request, req proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return status.Errorf(codes.ResourceExhausted, "request body exceeds %d bytes", tooLarge.Limit)
+		}
+		return status.Errorf(codes.InvalidArgument, "read request body: %v", err)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		return status.Errorf(codes.InvalidArgument, "request body: %v", err)
+	}
+	return nil
+}
+
+func (g *Gateway) write(w http.ResponseWriter, r *http.Request, code int, resp proto.Message) {
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		g.logger.ErrorContext(r.Context(), "marshal response failed", "path", r.URL.Path, "error", err)
+		code = http.StatusInternalServerError
+		data, _ = protojson.Marshal(status.New(codes.Internal, "cannot encode the response").Proto())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(data); err != nil {
+		g.logger.WarnContext(r.Context(), "write response failed", "path", r.URL.Path, "error", err)
+	}
+}
+
+// writeError sends err as a status with the HTTP status of its code.
+func (g *Gateway) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	g.writeStatus(w, r, HTTPStatus(st.Code()), st)
+}
+
+func (g *Gateway) writeStatus(w http.ResponseWriter, r *http.Request, code int, st *status.Status) {
+	g.write(w, r, code, st.Proto())
+}
+
+// HTTPStatus returns the HTTP status of a gRPC status code.
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		// Not a standard status; nginx uses it for a client that went away.
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
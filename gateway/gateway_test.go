@@ -0,0 +1,405 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/auth"
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// fakeServer records the request and the caller of the methods it
+// implements; the others answer Unimplemented.
+type fakeServer struct {
+	pb.UnimplementedWordServiceServer
+
+	req           proto.Message
+	identity      auth.Identity
+	authorization []string
+}
+
+func (f *fakeServer) record(ctx context.Context, req proto.Message) {
+	f.req = req
+	f.identity, _ = auth.FromContext(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+	f.authorization = md.Get("authorization")
+}
+
+func (f *fakeServer) FindWordByDictionary(ctx context.Context, req *pb.WordRequest) (*pb.WordResponse, error) {
+	f.record(ctx, req)
+	return &pb.WordResponse{WordMeanings: []*pb.WordMeaning{{Word: req.GetWord()}}}, nil
+}
+
+func (f *fakeServer) FindFavoriteWordMeanings(
+	ctx context.Context,
+	req *pb.FindFavoriteWordMeaningsRequest,
+) (*pb.FindFavoriteWordMeaningsResponse, error) {
+	f.record(ctx, req)
+	return &pb.FindFavoriteWordMeaningsResponse{}, nil
+}
+
+func (f *fakeServer) DeleteFavoriteWordMeaning(
+	ctx context.Context,
+	req *pb.DeleteFavoriteWordMeaningRequest,
+) (*pb.DeleteFavoriteWordMeaningResponse, error) {
+	f.record(ctx, req)
+	return &pb.DeleteFavoriteWordMeaningResponse{}, nil
+}
+
+func (f *fakeServer) UpdateQuestion(
+	ctx context.Context,
+	req *pb.UpdateQuestionRequest,
+) (*pb.UpdateQuestionResponse, error) {
+	f.record(ctx, req)
+	return &pb.UpdateQuestionResponse{}, nil
+}
+
+// do sends a request to handler and returns the response with its body.
+func do(t *testing.T, handler http.Handler, method, target, body string, header http.Header) (*http.Response, []byte) {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	resp := rec.Result()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, data
+}
+
+// errorStatus decodes the status in the body of an error response.
+func errorStatus(t *testing.T, body []byte) *statuspb.Status {
+	t.Helper()
+	st := &statuspb.Status{}
+	if err := protojson.Unmarshal(body, st); err != nil {
+		t.Fatalf("error body %s: %v", body, err)
+	}
+	return st
+}
+
+func TestRoutes(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		rpc    string
+	}{
+		{http.MethodGet, "/words/run", "FindWordByDictionary"},
+		{http.MethodGet, "/favorites", "FindFavoriteWordMeanings"},
+		{http.MethodPost, "/favorites", "CreateFavoriteWordMeaning"},
+		{http.MethodDelete, "/favorites/f1", "DeleteFavoriteWordMeaning"},
+		{http.MethodGet, "/exams", "FindExams"},
+		{http.MethodPost, "/exams", "CreateExam"},
+		{http.MethodPut, "/exams/e1", "UpdateExam"},
+		{http.MethodDelete, "/exams/e1", "DeleteExam"},
+		{http.MethodGet, "/exams/e1/questions", "FindQuestions"},
+		{http.MethodPost, "/exams/e1/questions", "CreateQuestion"},
+		{http.MethodPut, "/questions/q1", "UpdateQuestion"},
+		{http.MethodDelete, "/questions/q1", "DeleteQuestion"},
+		{http.MethodGet, "/exams/e1/records", "FindExamRecords"},
+		{http.MethodPost, "/exams/e1/records", "CreateExamRecord"},
+	}
+
+	// Every method of an empty server answers Unimplemented, naming itself.
+	g := New(pb.UnimplementedWordServiceServer{})
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			resp, body := do(t, g, tt.method, tt.path, "", nil)
+			if resp.StatusCode != http.StatusNotImplemented {
+				t.Fatalf("status = %d, want %d: %s", resp.StatusCode, http.StatusNotImplemented, body)
+			}
+			if got := errorStatus(t, body).GetMessage(); !strings.Contains(got, "method "+tt.rpc+" ") {
+				t.Errorf("message = %q, want it to name %s", got, tt.rpc)
+			}
+			if got := resp.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("Content-Type = %q", got)
+			}
+		})
+	}
+}
+
+func TestUnroutable(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		want      int
+		wantAllow string
+	}{
+		{"unknown path", http.MethodGet, "/nothing", http.StatusNotFound, ""},
+		{"missing path parameter", http.MethodGet, "/words/", http.StatusNotFound, ""},
+		{"too many segments", http.MethodGet, "/words/run/more", http.StatusNotFound, ""},
+		{"wrong method", http.MethodPatch, "/exams/e1", http.StatusMethodNotAllowed, "PUT, DELETE"},
+		{"wrong method on a collection", http.MethodDelete, "/favorites", http.StatusMethodNotAllowed, "GET, POST"},
+	}
+
+	g := New(pb.UnimplementedWordServiceServer{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := do(t, g, tt.method, tt.path, "", nil)
+			if resp.StatusCode != tt.want {
+				t.Errorf("status = %d, want %d: %s", resp.StatusCode, tt.want, body)
+			}
+			if got := resp.Header.Get("Allow"); got != tt.wantAllow {
+				t.Errorf("Allow = %q, want %q", got, tt.wantAllow)
+			}
+			errorStatus(t, body)
+		})
+	}
+}
+
+func TestBinding(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		want   proto.Message
+	}{
+		{
+			name:   "path parameter",
+			method: http.MethodGet,
+			target: "/words/run",
+			want:   &pb.WordRequest{Word: "run"},
+		},
+		{
+			name:   "query by proto and JSON names, enum by name",
+			method: http.MethodGet,
+			target: "/words/run?max_meanings=3&orderBy=WORD_MEANING_ORDER_FREQUENCY&partOfSpeech=verb",
+			want: &pb.WordRequest{
+				Word:         "run",
+				MaxMeanings:  3,
+				OrderBy:      pb.WordMeaningOrder_WORD_MEANING_ORDER_FREQUENCY,
+				PartOfSpeech: "verb",
+			},
+		},
+		{
+			name:   "enum by number",
+			method: http.MethodGet,
+			target: "/words/run?order_by=2",
+			want:   &pb.WordRequest{Word: "run", OrderBy: pb.WordMeaningOrder_WORD_MEANING_ORDER_FREQUENCY},
+		},
+		{
+			name:   "escaped path parameter",
+			method: http.MethodGet,
+			target: "/words/ice%20cream",
+			want:   &pb.WordRequest{Word: "ice cream"},
+		},
+		{
+			name:   "query and path",
+			method: http.MethodDelete,
+			target: "/favorites/f1?user_id=u1",
+			want:   &pb.DeleteFavoriteWordMeaningRequest{UserId: "u1", FavoriteWordMeaningId: "f1"},
+		},
+		{
+			name:   "body, with the path taking precedence",
+			method: http.MethodPut,
+			target: "/questions/q1",
+			body:   `{"questionId": "other", "ask": "Which?", "answers": ["a", "b"], "correct_answer": "a"}`,
+			want: &pb.UpdateQuestionRequest{
+				QuestionId:    "q1",
+				Ask:           "Which?",
+				Answers:       []string{"a", "b"},
+				CorrectAnswer: "a",
+			},
+		},
+		{
+			name:   "repeated query parameter",
+			method: http.MethodPut,
+			target: "/questions/q1?answers=a&answers=b",
+			want:   &pb.UpdateQuestionRequest{QuestionId: "q1", Answers: []string{"a", "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeServer{}
+			resp, body := do(t, New(server), tt.method, tt.target, tt.body, nil)
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", resp.StatusCode, body)
+			}
+			if !proto.Equal(server.req, tt.want) {
+				t.Errorf("request = %v, want %v", server.req, tt.want)
+			}
+		})
+	}
+}
+
+func TestBadRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+	}{
+		{"unknown parameter", http.MethodGet, "/words/run?colour=red", ""},
+		{"malformed number", http.MethodGet, "/words/run?max_meanings=many", ""},
+		{"unknown enum value", http.MethodGet, "/words/run?order_by=ALPHABETICAL", ""},
+		{"scalar given twice", http.MethodGet, "/words/run?max_meanings=1&max_meanings=2", ""},
+		{"message in the query", http.MethodPost, "/exams/e1/records?started_at=now", ""},
+		{"malformed body", http.MethodPut, "/questions/q1", `{"ask": `},
+		{"unknown body field", http.MethodPut, "/questions/q1", `{"colour": "red"}`},
+	}
+
+	g := New(&fakeServer{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := do(t, g, tt.method, tt.target, tt.body, nil)
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Fatalf("status = %d, want 400: %s", resp.StatusCode, body)
+			}
+			if got := codes.Code(errorStatus(t, body).GetCode()); got != codes.InvalidArgument {
+				t.Errorf("code = %v, want %v", got, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	permissions := map[string]auth.Permission{
+		"FindWordByDictionary":      auth.PermissionPublic,
+		"FindFavoriteWordMeanings":  auth.PermissionUser,
+		"DeleteFavoriteWordMeaning": auth.PermissionEditDictionary,
+	}
+	policy := func(fullMethod string) (auth.Permission, bool) {
+		name, _ := strings.CutPrefix(fullMethod, "/"+pb.WordService_ServiceDesc.ServiceName+"/")
+		permission, ok := permissions[name]
+		return permission, ok
+	}
+	authenticator := auth.New([]byte("test key"), policy)
+	token := func(role auth.Role) http.Header {
+		t.Helper()
+		raw, err := authenticator.NewToken("u1", role, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return http.Header{"Authorization": {"Bearer " + raw}}
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		header       http.Header
+		want         int
+		wantIdentity auth.Identity
+	}{
+		{"public, anonymous", http.MethodGet, "/words/run", nil, http.StatusOK, auth.Identity{}},
+		{
+			"public, with a token", http.MethodGet, "/words/run", token(auth.RoleLearner),
+			http.StatusOK, auth.Identity{UserID: "u1", Role: auth.RoleLearner},
+		},
+		{"user, anonymous", http.MethodGet, "/favorites", nil, http.StatusUnauthorized, auth.Identity{}},
+		{
+			"user, bad token", http.MethodGet, "/favorites", http.Header{"Authorization": {"Bearer nonsense"}},
+			http.StatusUnauthorized, auth.Identity{},
+		},
+		{
+			"user, not a bearer token", http.MethodGet, "/favorites", http.Header{"Authorization": {"Basic dTE6cHc="}},
+			http.StatusUnauthorized, auth.Identity{},
+		},
+		{
+			"user, with a token", http.MethodGet, "/favorites", token(auth.RoleLearner),
+			http.StatusOK, auth.Identity{UserID: "u1", Role: auth.RoleLearner},
+		},
+		{"permission lacking", http.MethodDelete, "/favorites/f1", token(auth.RoleLearner), http.StatusForbidden, auth.Identity{}},
+		{
+			"permission held", http.MethodDelete, "/favorites/f1", token(auth.RoleEditor),
+			http.StatusOK, auth.Identity{UserID: "u1", Role: auth.RoleEditor},
+		},
+		{"method outside the policy", http.MethodPut, "/questions/q1", token(auth.RoleAdmin), http.StatusForbidden, auth.Identity{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeServer{}
+			g := New(server, WithAuthenticator(authenticator))
+			resp, body := do(t, g, tt.method, tt.target, "", tt.header)
+			if resp.StatusCode != tt.want {
+				t.Fatalf("status = %d, want %d: %s", resp.StatusCode, tt.want, body)
+			}
+			wantChallenge := ""
+			if tt.want == http.StatusUnauthorized {
+				wantChallenge = "Bearer"
+			}
+			if got := resp.Header.Get("WWW-Authenticate"); got != wantChallenge {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, wantChallenge)
+			}
+			if tt.want != http.StatusOK {
+				if server.req != nil {
+					t.Error("the server was called")
+				}
+				return
+			}
+			if server.identity != tt.wantIdentity {
+				t.Errorf("identity = %+v, want %+v", server.identity, tt.wantIdentity)
+			}
+		})
+	}
+}
+
+func TestBearerTokenPassThrough(t *testing.T) {
+	// Without an authenticator the token reaches the server as gRPC
+	// metadata, as it would over gRPC.
+	server := &fakeServer{}
+	header := http.Header{"Authorization": {"Bearer abc"}}
+	resp, body := do(t, New(server), http.MethodGet, "/favorites", "", header)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", resp.StatusCode, body)
+	}
+	if len(server.authorization) != 1 || server.authorization[0] != "Bearer abc" {
+		t.Errorf("authorization metadata = %q, want [Bearer abc]", server.authorization)
+	}
+	if server.identity != (auth.Identity{}) {
+		t.Errorf("identity = %+v, want none", server.identity)
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.Canceled, 499},
+		{codes.Unknown, http.StatusInternalServerError},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Aborted, http.StatusConflict},
+		{codes.OutOfRange, http.StatusBadRequest},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DataLoss, http.StatusInternalServerError},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		if got := HTTPStatus(tt.code); got != tt.want {
+			t.Errorf("HTTPStatus(%v) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
@@ -3,14 +3,17 @@ module github.com/kakurineuin/learn-english-word
 go 1.21.5
 
 require (
+	github.com/golang-jwt/jwt/v5 v5.2.0
+	go.etcd.io/bbolt v1.3.8
+	golang.org/x/net v0.16.0
+	golang.org/x/sync v0.5.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20231002182017-d307bd883b97
 	google.golang.org/grpc v1.60.0
 	google.golang.org/protobuf v1.31.0
 )
 
 require (
 	github.com/golang/protobuf v1.5.3 // indirect
-	golang.org/x/net v0.16.0 // indirect
 	golang.org/x/sys v0.13.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20231002182017-d307bd883b97 // indirect
 )
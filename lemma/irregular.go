@@ -0,0 +1,226 @@
+package lemma
+
+// irregularVerbs lists base form, past tense and past participle. Forms with
+// spelling variants are listed once per variant.
+var irregularVerbs = [][3]string{
+	{"arise", "arose", "arisen"},
+	{"awake", "awoke", "awoken"},
+	{"be", "was", "been"},
+	{"be", "were", "been"},
+	{"bear", "bore", "borne"},
+	{"beat", "beat", "beaten"},
+	{"become", "became", "become"},
+	{"begin", "began", "begun"},
+	{"bend", "bent", "bent"},
+	{"bet", "bet", "bet"},
+	{"bind", "bound", "bound"},
+	{"bite", "bit", "bitten"},
+	{"bleed", "bled", "bled"},
+	{"blow", "blew", "blown"},
+	{"break", "broke", "broken"},
+	{"breed", "bred", "bred"},
+	{"bring", "brought", "brought"},
+	{"build", "built", "built"},
+	{"burn", "burnt", "burnt"},
+	{"buy", "bought", "bought"},
+	{"catch", "caught", "caught"},
+	{"choose", "chose", "chosen"},
+	{"cling", "clung", "clung"},
+	{"come", "came", "come"},
+	{"cost", "cost", "cost"},
+	{"creep", "crept", "crept"},
+	{"cut", "cut", "cut"},
+	{"deal", "dealt", "dealt"},
+	{"dig", "dug", "dug"},
+	{"do", "did", "done"},
+	{"draw", "drew", "drawn"},
+	{"dream", "dreamt", "dreamt"},
+	{"drink", "drank", "drunk"},
+	{"drive", "drove", "driven"},
+	{"eat", "ate", "eaten"},
+	{"fall", "fell", "fallen"},
+	{"feed", "fed", "fed"},
+	{"feel", "felt", "felt"},
+	{"fight", "fought", "fought"},
+	{"find", "found", "found"},
+	{"flee", "fled", "fled"},
+	{"fly", "flew", "flown"},
+	{"forbid", "forbade", "forbidden"},
+	{"forget", "forgot", "forgotten"},
+	{"forgive", "forgave", "forgiven"},
+	{"freeze", "froze", "frozen"},
+	{"get", "got", "gotten"},
+	{"get", "got", "got"},
+	{"give", "gave", "given"},
+	{"go", "went", "gone"},
+	{"grind", "ground", "ground"},
+	{"grow", "grew", "grown"},
+	{"hang", "hung", "hung"},
+	{"have", "had", "had"},
+	{"hear", "heard", "heard"},
+	{"hide", "hid", "hidden"},
+	{"hit", "hit", "hit"},
+	{"hold", "held", "held"},
+	{"hurt", "hurt", "hurt"},
+	{"keep", "kept", "kept"},
+	{"kneel", "knelt", "knelt"},
+	{"know", "knew", "known"},
+	{"lay", "laid", "laid"},
+	{"lead", "led", "led"},
+	{"lean", "leant", "leant"},
+	{"leap", "leapt", "leapt"},
+	{"learn", "learnt", "learnt"},
+	{"leave", "left", "left"},
+	{"lend", "lent", "lent"},
+	{"let", "let", "let"},
+	{"lie", "lay", "lain"},
+	{"light", "lit", "lit"},
+	{"lose", "lost", "lost"},
+	{"make", "made", "made"},
+	{"mean", "meant", "meant"},
+	{"meet", "met", "met"},
+	{"pay", "paid", "paid"},
+	{"put", "put", "put"},
+	{"quit", "quit", "quit"},
+	{"read", "read", "read"},
+	{"ride", "rode", "ridden"},
+	{"ring", "rang", "rung"},
+	{"rise", "rose", "risen"},
+	{"run", "ran", "run"},
+	{"say", "said", "said"},
+	{"see", "saw", "seen"},
+	{"seek", "sought", "sought"},
+	{"sell", "sold", "sold"},
+	{"send", "sent", "sent"},
+	{"set", "set", "set"},
+	{"shake", "shook", "shaken"},
+	{"shine", "shone", "shone"},
+	{"shoot", "shot", "shot"},
+	{"show", "showed", "shown"},
+	{"shrink", "shrank", "shrunk"},
+	{"shut", "shut", "shut"},
+	{"sing", "sang", "sung"},
+	{"sink", "sank", "sunk"},
+	{"sit", "sat", "sat"},
+	{"sleep", "slept", "slept"},
+	{"slide", "slid", "slid"},
+	{"speak", "spoke", "spoken"},
+	{"spend", "spent", "spent"},
+	{"spin", "spun", "spun"},
+	{"split", "split", "split"},
+	{"spread", "spread", "spread"},
+	{"spring", "sprang", "sprung"},
+	{"stand", "stood", "stood"},
+	{"steal", "stole", "stolen"},
+	{"stick", "stuck", "stuck"},
+	{"sting", "stung", "stung"},
+	{"strike", "struck", "struck"},
+	{"swear", "swore", "sworn"},
+	{"sweep", "swept", "swept"},
+	{"swim", "swam", "swum"},
+	{"swing", "swung", "swung"},
+	{"take", "took", "taken"},
+	{"teach", "taught", "taught"},
+	{"tear", "tore", "torn"},
+	{"tell", "told", "told"},
+	{"think", "thought", "thought"},
+	{"throw", "threw", "thrown"},
+	{"understand", "understood", "understood"},
+	{"wake", "woke", "woken"},
+	{"wear", "wore", "worn"},
+	{"weep", "wept", "wept"},
+	{"win", "won", "won"},
+	{"wind", "wound", "wound"},
+	{"write", "wrote", "written"},
+}
+
+// stressedFinalSyllable lists common verbs of more than one syllable that
+// are stressed on the last one, so that their final consonant doubles before
+// a vowel suffix as that of a one syllable word does: "begin", "beginning".
+var stressedFinalSyllable = map[string]bool{
+	"abet":     true,
+	"acquit":   true,
+	"admit":    true,
+	"allot":    true,
+	"begin":    true,
+	"commit":   true,
+	"compel":   true,
+	"concur":   true,
+	"confer":   true,
+	"control":  true,
+	"defer":    true,
+	"deter":    true,
+	"embed":    true,
+	"emit":     true,
+	"equip":    true,
+	"excel":    true,
+	"expel":    true,
+	"forbid":   true,
+	"forget":   true,
+	"incur":    true,
+	"infer":    true,
+	"occur":    true,
+	"omit":     true,
+	"patrol":   true,
+	"permit":   true,
+	"prefer":   true,
+	"propel":   true,
+	"rebel":    true,
+	"recur":    true,
+	"refer":    true,
+	"regret":   true,
+	"repel":    true,
+	"submit":   true,
+	"transfer": true,
+	"transmit": true,
+}
+
+// irregularPresent lists present tense forms that suffix rules cannot derive.
+var irregularPresent = map[string][]string{
+	"be":   {"am", "is", "are"},
+	"have": {"has"},
+	"do":   {"does"},
+	"go":   {"goes"},
+}
+
+// irregularPlurals maps a singular noun to its plural.
+var irregularPlurals = map[string]string{
+	"analysis":   "analyses",
+	"cactus":     "cacti",
+	"child":      "children",
+	"crisis":     "crises",
+	"criterion":  "criteria",
+	"datum":      "data",
+	"foot":       "feet",
+	"goose":      "geese",
+	"half":       "halves",
+	"knife":      "knives",
+	"leaf":       "leaves",
+	"life":       "lives",
+	"loaf":       "loaves",
+	"louse":      "lice",
+	"man":        "men",
+	"mouse":      "mice",
+	"ox":         "oxen",
+	"person":     "people",
+	"phenomenon": "phenomena",
+	"thesis":     "theses",
+	"thief":      "thieves",
+	"tooth":      "teeth",
+	"wife":       "wives",
+	"wolf":       "wolves",
+	"woman":      "women",
+}
+
+// irregularComparisons lists an adjective or adverb with its comparative and
+// superlative.
+var irregularComparisons = [][3]string{
+	{"bad", "worse", "worst"},
+	{"far", "farther", "farthest"},
+	{"far", "further", "furthest"},
+	{"good", "better", "best"},
+	{"little", "less", "least"},
+	{"many", "more", "most"},
+	{"much", "more", "most"},
+	{"well", "better", "best"},
+}
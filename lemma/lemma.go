@@ -0,0 +1,253 @@
+// Package lemma maps inflected English word forms to the headwords they may
+// come from, and headwords to their inflected forms.
+package lemma
+
+import (
+	"slices"
+	"strings"
+)
+
+// irregularLemmas maps an irregular form to its headwords, and the other
+// maps a headword to its irregular forms in one word class.
+var (
+	irregularLemmas          = make(map[string][]string)
+	irregularVerbForms       = make(map[string][]string)
+	irregularNounForms       = make(map[string][]string)
+	irregularComparisonForms = make(map[string][]string)
+)
+
+func init() {
+	for _, verb := range irregularVerbs {
+		for _, form := range verb[1:] {
+			addIrregular(irregularVerbForms, verb[0], form)
+		}
+	}
+	for verb, forms := range irregularPresent {
+		for _, form := range forms {
+			addIrregular(irregularVerbForms, verb, form)
+		}
+	}
+	for singular, plural := range irregularPlurals {
+		addIrregular(irregularNounForms, singular, plural)
+	}
+	for _, comparison := range irregularComparisons {
+		for _, form := range comparison[1:] {
+			addIrregular(irregularComparisonForms, comparison[0], form)
+		}
+	}
+}
+
+func addIrregular(forms map[string][]string, lemma, form string) {
+	if form != lemma && !slices.Contains(forms[lemma], form) {
+		forms[lemma] = append(forms[lemma], form)
+	}
+	if form != lemma && !slices.Contains(irregularLemmas[form], lemma) {
+		irregularLemmas[form] = append(irregularLemmas[form], lemma)
+	}
+}
+
+// Lemmas returns the headwords word may be an inflected form of, most likely
+// first. word itself is not included. The candidates are not checked against
+// a dictionary, so some of them are not words.
+func Lemmas(word string) []string {
+	word = strings.ToLower(strings.TrimSpace(word))
+
+	var lemmas []string
+	add := func(candidate string) {
+		if len(candidate) > 1 && candidate != word && !slices.Contains(lemmas, candidate) {
+			lemmas = append(lemmas, candidate)
+		}
+	}
+
+	for _, lemma := range irregularLemmas[word] {
+		add(lemma)
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ies") && len(word) > 4:
+		add(strings.TrimSuffix(word, "ies") + "y")
+	case strings.HasSuffix(word, "es"):
+		add(strings.TrimSuffix(word, "es"))
+		add(strings.TrimSuffix(word, "s"))
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		add(strings.TrimSuffix(word, "s"))
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ying") && len(word) > 5:
+		stem := strings.TrimSuffix(word, "ying")
+		add(stem + "y")
+		add(stem + "ie")
+	case strings.HasSuffix(word, "ing") && len(word) > 4:
+		addStems(add, strings.TrimSuffix(word, "ing"))
+	}
+
+	switch {
+	case strings.HasSuffix(word, "ied") && len(word) > 4:
+		add(strings.TrimSuffix(word, "ied") + "y")
+	case strings.HasSuffix(word, "ed") && len(word) > 3:
+		addStems(add, strings.TrimSuffix(word, "ed"))
+	}
+
+	switch {
+	case strings.HasSuffix(word, "iest") && len(word) > 5:
+		add(strings.TrimSuffix(word, "iest") + "y")
+	case strings.HasSuffix(word, "est") && len(word) > 4:
+		addStems(add, strings.TrimSuffix(word, "est"))
+	case strings.HasSuffix(word, "ier") && len(word) > 4:
+		add(strings.TrimSuffix(word, "ier") + "y")
+	case strings.HasSuffix(word, "er") && len(word) > 3:
+		addStems(add, strings.TrimSuffix(word, "er"))
+	}
+
+	return lemmas
+}
+
+// addStems adds the headwords a stem left by removing a vowel suffix may come
+// from: the stem itself, the stem without a doubled final consonant, and the
+// stem with a silent e restored.
+func addStems(add func(string), stem string) {
+	add(stem)
+	if n := len(stem); n > 2 && stem[n-1] == stem[n-2] && !isVowel(stem[n-1]) {
+		add(stem[:n-1])
+	}
+	add(stem + "e")
+}
+
+// Forms returns lemma followed by its inflected forms for partOfSpeech, as
+// given by a dictionary entry ("noun", "verb", "adjective", ...). Both noun
+// and verb forms are returned when partOfSpeech is empty.
+func Forms(lemma, partOfSpeech string) []string {
+	lemma = strings.ToLower(strings.TrimSpace(lemma))
+	if lemma == "" {
+		return nil
+	}
+	partOfSpeech = strings.ToLower(partOfSpeech)
+
+	forms := []string{lemma}
+	add := func(form string) {
+		if !slices.Contains(forms, form) {
+			forms = append(forms, form)
+		}
+	}
+
+	isNoun := strings.Contains(partOfSpeech, "noun") || partOfSpeech == ""
+	isVerb := strings.Contains(partOfSpeech, "verb") && !strings.Contains(partOfSpeech, "adverb") ||
+		partOfSpeech == ""
+	isComparable := strings.Contains(partOfSpeech, "adjective") ||
+		strings.Contains(partOfSpeech, "adverb")
+
+	if isNoun && !strings.Contains(lemma, " ") {
+		if irregular, ok := irregularNounForms[lemma]; ok {
+			for _, form := range irregular {
+				add(form)
+			}
+		} else {
+			add(addS(lemma))
+		}
+	}
+	if isVerb && !strings.Contains(lemma, " ") {
+		irregular := irregularVerbForms[lemma]
+		for _, form := range irregular {
+			add(form)
+		}
+		if _, ok := irregularPresent[lemma]; !ok {
+			add(addS(lemma))
+		}
+		if len(irregular) == 0 {
+			add(addED(lemma))
+		}
+		add(addING(lemma))
+	}
+	if isComparable {
+		for _, form := range irregularComparisonForms[lemma] {
+			add(form)
+		}
+	}
+
+	return forms
+}
+
+// addS returns the plural of a regular noun or the third person singular of
+// a regular verb.
+func addS(word string) string {
+	switch {
+	case strings.HasSuffix(word, "z") && doublesFinalConsonant(word):
+		return word + "zes"
+	case hasAnySuffix(word, "s", "x", "z", "ch", "sh"):
+		return word + "es"
+	case endsInConsonantY(word):
+		return word[:len(word)-1] + "ies"
+	default:
+		return word + "s"
+	}
+}
+
+func addED(word string) string {
+	switch {
+	case strings.HasSuffix(word, "e"):
+		return word + "d"
+	case endsInConsonantY(word):
+		return word[:len(word)-1] + "ied"
+	case doublesFinalConsonant(word):
+		return word + word[len(word)-1:] + "ed"
+	default:
+		return word + "ed"
+	}
+}
+
+func addING(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ie"):
+		return word[:len(word)-2] + "ying"
+	case strings.HasSuffix(word, "e") && !hasAnySuffix(word, "ee", "ye", "oe") && len(word) > 2:
+		return word[:len(word)-1] + "ing"
+	case doublesFinalConsonant(word):
+		return word + word[len(word)-1:] + "ing"
+	default:
+		return word + "ing"
+	}
+}
+
+// doublesFinalConsonant reports whether the last letter of word doubles
+// before a vowel suffix: word is a one syllable word ending in consonant,
+// vowel, consonant, as in "run", "stop" and "quit", or one of
+// stressedFinalSyllable.
+func doublesFinalConsonant(word string) bool {
+	if stressedFinalSyllable[word] {
+		return true
+	}
+	// The u of "qu" stands for a consonant.
+	word = strings.ReplaceAll(word, "qu", "qw")
+	n := len(word)
+	if n < 3 || strings.ContainsRune("wxy", rune(word[n-1])) {
+		return false
+	}
+	if isVowel(word[n-1]) || !isVowel(word[n-2]) || isVowel(word[n-3]) {
+		return false
+	}
+	for i := 0; i < n-2; i++ {
+		if isVowel(word[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func endsInConsonantY(word string) bool {
+	n := len(word)
+	return n > 1 && word[n-1] == 'y' && !isVowel(word[n-2])
+}
+
+func hasAnySuffix(word string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
@@ -0,0 +1,69 @@
+package lemma
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestForms(t *testing.T) {
+	tests := []struct {
+		lemma        string
+		partOfSpeech string
+		want         []string
+	}{
+		{"walk", "verb", []string{"walk", "walks", "walked", "walking"}},
+		{"stop", "verb", []string{"stop", "stops", "stopped", "stopping"}},
+		{"run", "verb", []string{"run", "ran", "runs", "running"}},
+		{"open", "verb", []string{"open", "opens", "opened", "opening"}},
+		{"visit", "verb", []string{"visit", "visits", "visited", "visiting"}},
+		{"begin", "verb", []string{"begin", "began", "begun", "begins", "beginning"}},
+		{"forget", "verb", []string{"forget", "forgot", "forgotten", "forgets", "forgetting"}},
+		{"occur", "verb", []string{"occur", "occurs", "occurred", "occurring"}},
+		{"prefer", "verb", []string{"prefer", "prefers", "preferred", "preferring"}},
+		{"quit", "verb", []string{"quit", "quits", "quitted", "quitting"}},
+		{"quiz", "verb", []string{"quiz", "quizzes", "quizzed", "quizzing"}},
+		{"equip", "verb", []string{"equip", "equips", "equipped", "equipping"}},
+		{"fix", "verb", []string{"fix", "fixes", "fixed", "fixing"}},
+		{"play", "verb", []string{"play", "plays", "played", "playing"}},
+		{"study", "verb", []string{"study", "studies", "studied", "studying"}},
+		{"make", "verb", []string{"make", "made", "makes", "making"}},
+		{"see", "verb", []string{"see", "saw", "seen", "sees", "seeing"}},
+		{"lie", "verb", []string{"lie", "lay", "lain", "lies", "lying"}},
+		{"go", "verb", []string{"go", "went", "gone", "goes", "going"}},
+		{"box", "noun", []string{"box", "boxes"}},
+		{"quiz", "noun", []string{"quiz", "quizzes"}},
+		{"child", "noun", []string{"child", "children"}},
+		{"good", "adjective", []string{"good", "better", "best"}},
+		{"give up", "phrasal verb", []string{"give up"}},
+	}
+
+	for _, tt := range tests {
+		got := Forms(tt.lemma, tt.partOfSpeech)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Forms(%q, %q) = %q, want %q", tt.lemma, tt.partOfSpeech, got, tt.want)
+		}
+	}
+}
+
+func TestLemmas(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"beginning", "begin"},
+		{"occurred", "occur"},
+		{"quitting", "quit"},
+		{"stopped", "stop"},
+		{"studies", "study"},
+		{"making", "make"},
+		{"children", "child"},
+		{"went", "go"},
+		{"better", "good"},
+	}
+
+	for _, tt := range tests {
+		if got := Lemmas(tt.word); !slices.Contains(got, tt.want) {
+			t.Errorf("Lemmas(%q) = %q, want it to contain %q", tt.word, got, tt.want)
+		}
+	}
+}
@@ -23,6 +23,34 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WordServiceClient interface {
 	FindWordByDictionary(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*WordResponse, error)
+	FindWordsByDictionary(ctx context.Context, in *FindWordsByDictionaryRequest, opts ...grpc.CallOption) (*FindWordsByDictionaryResponse, error)
+	// Streams one result per distinct word as soon as it is resolved, so
+	// results may arrive in any order.
+	StreamWordsByDictionary(ctx context.Context, in *FindWordsByDictionaryRequest, opts ...grpc.CallOption) (WordService_StreamWordsByDictionaryClient, error)
+	CreateFavoriteWordMeaning(ctx context.Context, in *CreateFavoriteWordMeaningRequest, opts ...grpc.CallOption) (*CreateFavoriteWordMeaningResponse, error)
+	DeleteFavoriteWordMeaning(ctx context.Context, in *DeleteFavoriteWordMeaningRequest, opts ...grpc.CallOption) (*DeleteFavoriteWordMeaningResponse, error)
+	FindFavoriteWordMeanings(ctx context.Context, in *FindFavoriteWordMeaningsRequest, opts ...grpc.CallOption) (*FindFavoriteWordMeaningsResponse, error)
+	CreateExam(ctx context.Context, in *CreateExamRequest, opts ...grpc.CallOption) (*CreateExamResponse, error)
+	UpdateExam(ctx context.Context, in *UpdateExamRequest, opts ...grpc.CallOption) (*UpdateExamResponse, error)
+	DeleteExam(ctx context.Context, in *DeleteExamRequest, opts ...grpc.CallOption) (*DeleteExamResponse, error)
+	FindExams(ctx context.Context, in *FindExamsRequest, opts ...grpc.CallOption) (*FindExamsResponse, error)
+	CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error)
+	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error)
+	DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error)
+	FindQuestions(ctx context.Context, in *FindQuestionsRequest, opts ...grpc.CallOption) (*FindQuestionsResponse, error)
+	GenerateQuestions(ctx context.Context, in *GenerateQuestionsRequest, opts ...grpc.CallOption) (*GenerateQuestionsResponse, error)
+	CreateExamRecord(ctx context.Context, in *CreateExamRecordRequest, opts ...grpc.CallOption) (*CreateExamRecordResponse, error)
+	FindExamRecords(ctx context.Context, in *FindExamRecordsRequest, opts ...grpc.CallOption) (*FindExamRecordsResponse, error)
+	GetDueReviews(ctx context.Context, in *GetDueReviewsRequest, opts ...grpc.CallOption) (*GetDueReviewsResponse, error)
+	SubmitReviewGrade(ctx context.Context, in *SubmitReviewGradeRequest, opts ...grpc.CallOption) (*SubmitReviewGradeResponse, error)
+	AutocompleteWords(ctx context.Context, in *AutocompleteWordsRequest, opts ...grpc.CallOption) (*AutocompleteWordsResponse, error)
+	SearchByDefinition(ctx context.Context, in *SearchByDefinitionRequest, opts ...grpc.CallOption) (*SearchByDefinitionResponse, error)
+	// Dictionary edits, for editors only.
+	CreateWordMeaning(ctx context.Context, in *CreateWordMeaningRequest, opts ...grpc.CallOption) (*CreateWordMeaningResponse, error)
+	UpdateWordMeaning(ctx context.Context, in *UpdateWordMeaningRequest, opts ...grpc.CallOption) (*UpdateWordMeaningResponse, error)
+	DeleteWordMeaning(ctx context.Context, in *DeleteWordMeaningRequest, opts ...grpc.CallOption) (*DeleteWordMeaningResponse, error)
+	ListWordMeaningRevisions(ctx context.Context, in *ListWordMeaningRevisionsRequest, opts ...grpc.CallOption) (*ListWordMeaningRevisionsResponse, error)
+	RevertWordMeaning(ctx context.Context, in *RevertWordMeaningRequest, opts ...grpc.CallOption) (*RevertWordMeaningResponse, error)
 }
 
 type wordServiceClient struct {
@@ -42,11 +70,287 @@ func (c *wordServiceClient) FindWordByDictionary(ctx context.Context, in *WordRe
 	return out, nil
 }
 
+func (c *wordServiceClient) FindWordsByDictionary(ctx context.Context, in *FindWordsByDictionaryRequest, opts ...grpc.CallOption) (*FindWordsByDictionaryResponse, error) {
+	out := new(FindWordsByDictionaryResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindWordsByDictionary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) StreamWordsByDictionary(ctx context.Context, in *FindWordsByDictionaryRequest, opts ...grpc.CallOption) (WordService_StreamWordsByDictionaryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WordService_ServiceDesc.Streams[0], "/pb.WordService/StreamWordsByDictionary", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wordServiceStreamWordsByDictionaryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WordService_StreamWordsByDictionaryClient interface {
+	Recv() (*WordResult, error)
+	grpc.ClientStream
+}
+
+type wordServiceStreamWordsByDictionaryClient struct {
+	grpc.ClientStream
+}
+
+func (x *wordServiceStreamWordsByDictionaryClient) Recv() (*WordResult, error) {
+	m := new(WordResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wordServiceClient) CreateFavoriteWordMeaning(ctx context.Context, in *CreateFavoriteWordMeaningRequest, opts ...grpc.CallOption) (*CreateFavoriteWordMeaningResponse, error) {
+	out := new(CreateFavoriteWordMeaningResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/CreateFavoriteWordMeaning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) DeleteFavoriteWordMeaning(ctx context.Context, in *DeleteFavoriteWordMeaningRequest, opts ...grpc.CallOption) (*DeleteFavoriteWordMeaningResponse, error) {
+	out := new(DeleteFavoriteWordMeaningResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/DeleteFavoriteWordMeaning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindFavoriteWordMeanings(ctx context.Context, in *FindFavoriteWordMeaningsRequest, opts ...grpc.CallOption) (*FindFavoriteWordMeaningsResponse, error) {
+	out := new(FindFavoriteWordMeaningsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindFavoriteWordMeanings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) CreateExam(ctx context.Context, in *CreateExamRequest, opts ...grpc.CallOption) (*CreateExamResponse, error) {
+	out := new(CreateExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/CreateExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) UpdateExam(ctx context.Context, in *UpdateExamRequest, opts ...grpc.CallOption) (*UpdateExamResponse, error) {
+	out := new(UpdateExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/UpdateExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) DeleteExam(ctx context.Context, in *DeleteExamRequest, opts ...grpc.CallOption) (*DeleteExamResponse, error) {
+	out := new(DeleteExamResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/DeleteExam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindExams(ctx context.Context, in *FindExamsRequest, opts ...grpc.CallOption) (*FindExamsResponse, error) {
+	out := new(FindExamsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindExams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) CreateQuestion(ctx context.Context, in *CreateQuestionRequest, opts ...grpc.CallOption) (*CreateQuestionResponse, error) {
+	out := new(CreateQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/CreateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error) {
+	out := new(UpdateQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/UpdateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) DeleteQuestion(ctx context.Context, in *DeleteQuestionRequest, opts ...grpc.CallOption) (*DeleteQuestionResponse, error) {
+	out := new(DeleteQuestionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/DeleteQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindQuestions(ctx context.Context, in *FindQuestionsRequest, opts ...grpc.CallOption) (*FindQuestionsResponse, error) {
+	out := new(FindQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) GenerateQuestions(ctx context.Context, in *GenerateQuestionsRequest, opts ...grpc.CallOption) (*GenerateQuestionsResponse, error) {
+	out := new(GenerateQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/GenerateQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) CreateExamRecord(ctx context.Context, in *CreateExamRecordRequest, opts ...grpc.CallOption) (*CreateExamRecordResponse, error) {
+	out := new(CreateExamRecordResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/CreateExamRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) FindExamRecords(ctx context.Context, in *FindExamRecordsRequest, opts ...grpc.CallOption) (*FindExamRecordsResponse, error) {
+	out := new(FindExamRecordsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/FindExamRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) GetDueReviews(ctx context.Context, in *GetDueReviewsRequest, opts ...grpc.CallOption) (*GetDueReviewsResponse, error) {
+	out := new(GetDueReviewsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/GetDueReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) SubmitReviewGrade(ctx context.Context, in *SubmitReviewGradeRequest, opts ...grpc.CallOption) (*SubmitReviewGradeResponse, error) {
+	out := new(SubmitReviewGradeResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/SubmitReviewGrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) AutocompleteWords(ctx context.Context, in *AutocompleteWordsRequest, opts ...grpc.CallOption) (*AutocompleteWordsResponse, error) {
+	out := new(AutocompleteWordsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/AutocompleteWords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) SearchByDefinition(ctx context.Context, in *SearchByDefinitionRequest, opts ...grpc.CallOption) (*SearchByDefinitionResponse, error) {
+	out := new(SearchByDefinitionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/SearchByDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) CreateWordMeaning(ctx context.Context, in *CreateWordMeaningRequest, opts ...grpc.CallOption) (*CreateWordMeaningResponse, error) {
+	out := new(CreateWordMeaningResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/CreateWordMeaning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) UpdateWordMeaning(ctx context.Context, in *UpdateWordMeaningRequest, opts ...grpc.CallOption) (*UpdateWordMeaningResponse, error) {
+	out := new(UpdateWordMeaningResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/UpdateWordMeaning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) DeleteWordMeaning(ctx context.Context, in *DeleteWordMeaningRequest, opts ...grpc.CallOption) (*DeleteWordMeaningResponse, error) {
+	out := new(DeleteWordMeaningResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/DeleteWordMeaning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) ListWordMeaningRevisions(ctx context.Context, in *ListWordMeaningRevisionsRequest, opts ...grpc.CallOption) (*ListWordMeaningRevisionsResponse, error) {
+	out := new(ListWordMeaningRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/ListWordMeaningRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wordServiceClient) RevertWordMeaning(ctx context.Context, in *RevertWordMeaningRequest, opts ...grpc.CallOption) (*RevertWordMeaningResponse, error) {
+	out := new(RevertWordMeaningResponse)
+	err := c.cc.Invoke(ctx, "/pb.WordService/RevertWordMeaning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WordServiceServer is the server API for WordService service.
 // All implementations must embed UnimplementedWordServiceServer
 // for forward compatibility
 type WordServiceServer interface {
 	FindWordByDictionary(context.Context, *WordRequest) (*WordResponse, error)
+	FindWordsByDictionary(context.Context, *FindWordsByDictionaryRequest) (*FindWordsByDictionaryResponse, error)
+	// Streams one result per distinct word as soon as it is resolved, so
+	// results may arrive in any order.
+	StreamWordsByDictionary(*FindWordsByDictionaryRequest, WordService_StreamWordsByDictionaryServer) error
+	CreateFavoriteWordMeaning(context.Context, *CreateFavoriteWordMeaningRequest) (*CreateFavoriteWordMeaningResponse, error)
+	DeleteFavoriteWordMeaning(context.Context, *DeleteFavoriteWordMeaningRequest) (*DeleteFavoriteWordMeaningResponse, error)
+	FindFavoriteWordMeanings(context.Context, *FindFavoriteWordMeaningsRequest) (*FindFavoriteWordMeaningsResponse, error)
+	CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error)
+	UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error)
+	DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error)
+	FindExams(context.Context, *FindExamsRequest) (*FindExamsResponse, error)
+	CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error)
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
+	DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error)
+	FindQuestions(context.Context, *FindQuestionsRequest) (*FindQuestionsResponse, error)
+	GenerateQuestions(context.Context, *GenerateQuestionsRequest) (*GenerateQuestionsResponse, error)
+	CreateExamRecord(context.Context, *CreateExamRecordRequest) (*CreateExamRecordResponse, error)
+	FindExamRecords(context.Context, *FindExamRecordsRequest) (*FindExamRecordsResponse, error)
+	GetDueReviews(context.Context, *GetDueReviewsRequest) (*GetDueReviewsResponse, error)
+	SubmitReviewGrade(context.Context, *SubmitReviewGradeRequest) (*SubmitReviewGradeResponse, error)
+	AutocompleteWords(context.Context, *AutocompleteWordsRequest) (*AutocompleteWordsResponse, error)
+	SearchByDefinition(context.Context, *SearchByDefinitionRequest) (*SearchByDefinitionResponse, error)
+	// Dictionary edits, for editors only.
+	CreateWordMeaning(context.Context, *CreateWordMeaningRequest) (*CreateWordMeaningResponse, error)
+	UpdateWordMeaning(context.Context, *UpdateWordMeaningRequest) (*UpdateWordMeaningResponse, error)
+	DeleteWordMeaning(context.Context, *DeleteWordMeaningRequest) (*DeleteWordMeaningResponse, error)
+	ListWordMeaningRevisions(context.Context, *ListWordMeaningRevisionsRequest) (*ListWordMeaningRevisionsResponse, error)
+	RevertWordMeaning(context.Context, *RevertWordMeaningRequest) (*RevertWordMeaningResponse, error)
 	mustEmbedUnimplementedWordServiceServer()
 }
 
@@ -57,6 +361,81 @@ type UnimplementedWordServiceServer struct {
 func (UnimplementedWordServiceServer) FindWordByDictionary(context.Context, *WordRequest) (*WordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindWordByDictionary not implemented")
 }
+func (UnimplementedWordServiceServer) FindWordsByDictionary(context.Context, *FindWordsByDictionaryRequest) (*FindWordsByDictionaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindWordsByDictionary not implemented")
+}
+func (UnimplementedWordServiceServer) StreamWordsByDictionary(*FindWordsByDictionaryRequest, WordService_StreamWordsByDictionaryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWordsByDictionary not implemented")
+}
+func (UnimplementedWordServiceServer) CreateFavoriteWordMeaning(context.Context, *CreateFavoriteWordMeaningRequest) (*CreateFavoriteWordMeaningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFavoriteWordMeaning not implemented")
+}
+func (UnimplementedWordServiceServer) DeleteFavoriteWordMeaning(context.Context, *DeleteFavoriteWordMeaningRequest) (*DeleteFavoriteWordMeaningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFavoriteWordMeaning not implemented")
+}
+func (UnimplementedWordServiceServer) FindFavoriteWordMeanings(context.Context, *FindFavoriteWordMeaningsRequest) (*FindFavoriteWordMeaningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFavoriteWordMeanings not implemented")
+}
+func (UnimplementedWordServiceServer) CreateExam(context.Context, *CreateExamRequest) (*CreateExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExam not implemented")
+}
+func (UnimplementedWordServiceServer) UpdateExam(context.Context, *UpdateExamRequest) (*UpdateExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExam not implemented")
+}
+func (UnimplementedWordServiceServer) DeleteExam(context.Context, *DeleteExamRequest) (*DeleteExamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExam not implemented")
+}
+func (UnimplementedWordServiceServer) FindExams(context.Context, *FindExamsRequest) (*FindExamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExams not implemented")
+}
+func (UnimplementedWordServiceServer) CreateQuestion(context.Context, *CreateQuestionRequest) (*CreateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
+}
+func (UnimplementedWordServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedWordServiceServer) DeleteQuestion(context.Context, *DeleteQuestionRequest) (*DeleteQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedWordServiceServer) FindQuestions(context.Context, *FindQuestionsRequest) (*FindQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindQuestions not implemented")
+}
+func (UnimplementedWordServiceServer) GenerateQuestions(context.Context, *GenerateQuestionsRequest) (*GenerateQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateQuestions not implemented")
+}
+func (UnimplementedWordServiceServer) CreateExamRecord(context.Context, *CreateExamRecordRequest) (*CreateExamRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExamRecord not implemented")
+}
+func (UnimplementedWordServiceServer) FindExamRecords(context.Context, *FindExamRecordsRequest) (*FindExamRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindExamRecords not implemented")
+}
+func (UnimplementedWordServiceServer) GetDueReviews(context.Context, *GetDueReviewsRequest) (*GetDueReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDueReviews not implemented")
+}
+func (UnimplementedWordServiceServer) SubmitReviewGrade(context.Context, *SubmitReviewGradeRequest) (*SubmitReviewGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReviewGrade not implemented")
+}
+func (UnimplementedWordServiceServer) AutocompleteWords(context.Context, *AutocompleteWordsRequest) (*AutocompleteWordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutocompleteWords not implemented")
+}
+func (UnimplementedWordServiceServer) SearchByDefinition(context.Context, *SearchByDefinitionRequest) (*SearchByDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchByDefinition not implemented")
+}
+func (UnimplementedWordServiceServer) CreateWordMeaning(context.Context, *CreateWordMeaningRequest) (*CreateWordMeaningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWordMeaning not implemented")
+}
+func (UnimplementedWordServiceServer) UpdateWordMeaning(context.Context, *UpdateWordMeaningRequest) (*UpdateWordMeaningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWordMeaning not implemented")
+}
+func (UnimplementedWordServiceServer) DeleteWordMeaning(context.Context, *DeleteWordMeaningRequest) (*DeleteWordMeaningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWordMeaning not implemented")
+}
+func (UnimplementedWordServiceServer) ListWordMeaningRevisions(context.Context, *ListWordMeaningRevisionsRequest) (*ListWordMeaningRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWordMeaningRevisions not implemented")
+}
+func (UnimplementedWordServiceServer) RevertWordMeaning(context.Context, *RevertWordMeaningRequest) (*RevertWordMeaningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertWordMeaning not implemented")
+}
 func (UnimplementedWordServiceServer) mustEmbedUnimplementedWordServiceServer() {}
 
 // UnsafeWordServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -88,18 +467,573 @@ func _WordService_FindWordByDictionary_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-// WordService_ServiceDesc is the grpc.ServiceDesc for WordService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WordService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.WordService",
-	HandlerType: (*WordServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FindWordByDictionary",
-			Handler:    _WordService_FindWordByDictionary_Handler,
+func _WordService_FindWordsByDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindWordsByDictionaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindWordsByDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindWordsByDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindWordsByDictionary(ctx, req.(*FindWordsByDictionaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_StreamWordsByDictionary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FindWordsByDictionaryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WordServiceServer).StreamWordsByDictionary(m, &wordServiceStreamWordsByDictionaryServer{stream})
+}
+
+type WordService_StreamWordsByDictionaryServer interface {
+	Send(*WordResult) error
+	grpc.ServerStream
+}
+
+type wordServiceStreamWordsByDictionaryServer struct {
+	grpc.ServerStream
+}
+
+func (x *wordServiceStreamWordsByDictionaryServer) Send(m *WordResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WordService_CreateFavoriteWordMeaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFavoriteWordMeaningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).CreateFavoriteWordMeaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/CreateFavoriteWordMeaning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).CreateFavoriteWordMeaning(ctx, req.(*CreateFavoriteWordMeaningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_DeleteFavoriteWordMeaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFavoriteWordMeaningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).DeleteFavoriteWordMeaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/DeleteFavoriteWordMeaning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).DeleteFavoriteWordMeaning(ctx, req.(*DeleteFavoriteWordMeaningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindFavoriteWordMeanings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFavoriteWordMeaningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindFavoriteWordMeanings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindFavoriteWordMeanings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindFavoriteWordMeanings(ctx, req.(*FindFavoriteWordMeaningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_CreateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).CreateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/CreateExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).CreateExam(ctx, req.(*CreateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_UpdateExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).UpdateExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/UpdateExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).UpdateExam(ctx, req.(*UpdateExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_DeleteExam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).DeleteExam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/DeleteExam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).DeleteExam(ctx, req.(*DeleteExamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindExams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindExams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindExams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindExams(ctx, req.(*FindExamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).CreateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/CreateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).CreateQuestion(ctx, req.(*CreateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/UpdateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/DeleteQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).DeleteQuestion(ctx, req.(*DeleteQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindQuestions(ctx, req.(*FindQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_GenerateQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).GenerateQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/GenerateQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).GenerateQuestions(ctx, req.(*GenerateQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_CreateExamRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExamRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).CreateExamRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/CreateExamRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).CreateExamRecord(ctx, req.(*CreateExamRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_FindExamRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindExamRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).FindExamRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/FindExamRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).FindExamRecords(ctx, req.(*FindExamRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_GetDueReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDueReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).GetDueReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/GetDueReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).GetDueReviews(ctx, req.(*GetDueReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_SubmitReviewGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).SubmitReviewGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/SubmitReviewGrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).SubmitReviewGrade(ctx, req.(*SubmitReviewGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_AutocompleteWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).AutocompleteWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/AutocompleteWords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).AutocompleteWords(ctx, req.(*AutocompleteWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_SearchByDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchByDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).SearchByDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/SearchByDefinition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).SearchByDefinition(ctx, req.(*SearchByDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_CreateWordMeaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWordMeaningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).CreateWordMeaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/CreateWordMeaning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).CreateWordMeaning(ctx, req.(*CreateWordMeaningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_UpdateWordMeaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWordMeaningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).UpdateWordMeaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/UpdateWordMeaning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).UpdateWordMeaning(ctx, req.(*UpdateWordMeaningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_DeleteWordMeaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWordMeaningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).DeleteWordMeaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/DeleteWordMeaning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).DeleteWordMeaning(ctx, req.(*DeleteWordMeaningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_ListWordMeaningRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWordMeaningRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).ListWordMeaningRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/ListWordMeaningRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).ListWordMeaningRevisions(ctx, req.(*ListWordMeaningRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WordService_RevertWordMeaning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertWordMeaningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WordServiceServer).RevertWordMeaning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WordService/RevertWordMeaning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WordServiceServer).RevertWordMeaning(ctx, req.(*RevertWordMeaningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WordService_ServiceDesc is the grpc.ServiceDesc for WordService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WordService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.WordService",
+	HandlerType: (*WordServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindWordByDictionary",
+			Handler:    _WordService_FindWordByDictionary_Handler,
+		},
+		{
+			MethodName: "FindWordsByDictionary",
+			Handler:    _WordService_FindWordsByDictionary_Handler,
+		},
+		{
+			MethodName: "CreateFavoriteWordMeaning",
+			Handler:    _WordService_CreateFavoriteWordMeaning_Handler,
+		},
+		{
+			MethodName: "DeleteFavoriteWordMeaning",
+			Handler:    _WordService_DeleteFavoriteWordMeaning_Handler,
+		},
+		{
+			MethodName: "FindFavoriteWordMeanings",
+			Handler:    _WordService_FindFavoriteWordMeanings_Handler,
+		},
+		{
+			MethodName: "CreateExam",
+			Handler:    _WordService_CreateExam_Handler,
+		},
+		{
+			MethodName: "UpdateExam",
+			Handler:    _WordService_UpdateExam_Handler,
+		},
+		{
+			MethodName: "DeleteExam",
+			Handler:    _WordService_DeleteExam_Handler,
+		},
+		{
+			MethodName: "FindExams",
+			Handler:    _WordService_FindExams_Handler,
+		},
+		{
+			MethodName: "CreateQuestion",
+			Handler:    _WordService_CreateQuestion_Handler,
+		},
+		{
+			MethodName: "UpdateQuestion",
+			Handler:    _WordService_UpdateQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _WordService_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "FindQuestions",
+			Handler:    _WordService_FindQuestions_Handler,
+		},
+		{
+			MethodName: "GenerateQuestions",
+			Handler:    _WordService_GenerateQuestions_Handler,
+		},
+		{
+			MethodName: "CreateExamRecord",
+			Handler:    _WordService_CreateExamRecord_Handler,
+		},
+		{
+			MethodName: "FindExamRecords",
+			Handler:    _WordService_FindExamRecords_Handler,
+		},
+		{
+			MethodName: "GetDueReviews",
+			Handler:    _WordService_GetDueReviews_Handler,
+		},
+		{
+			MethodName: "SubmitReviewGrade",
+			Handler:    _WordService_SubmitReviewGrade_Handler,
+		},
+		{
+			MethodName: "AutocompleteWords",
+			Handler:    _WordService_AutocompleteWords_Handler,
+		},
+		{
+			MethodName: "SearchByDefinition",
+			Handler:    _WordService_SearchByDefinition_Handler,
+		},
+		{
+			MethodName: "CreateWordMeaning",
+			Handler:    _WordService_CreateWordMeaning_Handler,
+		},
+		{
+			MethodName: "UpdateWordMeaning",
+			Handler:    _WordService_UpdateWordMeaning_Handler,
+		},
+		{
+			MethodName: "DeleteWordMeaning",
+			Handler:    _WordService_DeleteWordMeaning_Handler,
+		},
+		{
+			MethodName: "ListWordMeaningRevisions",
+			Handler:    _WordService_ListWordMeaningRevisions_Handler,
+		},
+		{
+			MethodName: "RevertWordMeaning",
+			Handler:    _WordService_RevertWordMeaning_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWordsByDictionary",
+			Handler:       _WordService_StreamWordsByDictionary_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "word_service.proto",
 }
@@ -0,0 +1,192 @@
+// Package quiz generates multiple-choice questions from dictionary word
+// meanings.
+package quiz
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// Blank replaces the word in a cloze sentence.
+const Blank = "_____"
+
+// partsOfSpeech pads part of speech questions when the pool is too small.
+var partsOfSpeech = []string{
+	"noun", "verb", "adjective", "adverb", "preposition", "conjunction", "pronoun",
+}
+
+// Question is a generated multiple-choice question.
+type Question struct {
+	Type          pb.QuestionType
+	Ask           string
+	Answers       []string
+	CorrectAnswer string
+	WordMeaningID string
+}
+
+// Generator builds questions for a word meaning, drawing distractors from a
+// pool of other word meanings.
+type Generator struct {
+	// AnswerCount is the number of answers per question, correct one
+	// included.
+	AnswerCount int
+	Rand        *rand.Rand
+}
+
+// Generate returns one question of each of types that can be built for
+// target. A type is skipped when target lacks the data it needs or the pool
+// offers no distractor.
+func (g *Generator) Generate(
+	target *pb.WordMeaning,
+	pool []*pb.WordMeaning,
+	types []pb.QuestionType,
+) []*Question {
+	var questions []*Question
+	for _, t := range types {
+		var question *Question
+		switch t {
+		case pb.QuestionType_QUESTION_TYPE_DEFINITION:
+			question = g.definition(target, pool)
+		case pb.QuestionType_QUESTION_TYPE_CLOZE:
+			question = g.cloze(target, pool)
+		case pb.QuestionType_QUESTION_TYPE_PART_OF_SPEECH:
+			question = g.partOfSpeech(target, pool)
+		}
+		if question != nil {
+			question.Type = t
+			question.WordMeaningID = target.GetId()
+			questions = append(questions, question)
+		}
+	}
+	return questions
+}
+
+func (g *Generator) definition(target *pb.WordMeaning, pool []*pb.WordMeaning) *Question {
+	definition := strings.TrimSpace(target.GetDefinition())
+	if definition == "" {
+		return nil
+	}
+	// A definition using the word itself, as in "the act of running",
+	// would give the answer away.
+	if pattern := wordPattern(target.GetWord()); pattern != nil {
+		definition = pattern.ReplaceAllString(definition, Blank)
+	}
+	return g.choose(
+		fmt.Sprintf("Which word means %q?", definition),
+		target.GetWord(),
+		g.wordDistractors(target, pool),
+	)
+}
+
+func (g *Generator) cloze(target *pb.WordMeaning, pool []*pb.WordMeaning) *Question {
+	pattern := wordPattern(target.GetWord())
+	if pattern == nil {
+		return nil
+	}
+
+	var candidates []string
+	for _, example := range target.GetExamples() {
+		for _, sentence := range example.GetExamples() {
+			if pattern.MatchString(sentence.GetText()) {
+				candidates = append(candidates, sentence.GetText())
+			}
+		}
+		if pattern.MatchString(example.GetPattern()) {
+			candidates = append(candidates, example.GetPattern())
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	text := candidates[g.Rand.Intn(len(candidates))]
+	return g.choose(
+		fmt.Sprintf("Which word completes the sentence? %s", pattern.ReplaceAllString(text, Blank)),
+		target.GetWord(),
+		g.wordDistractors(target, pool),
+	)
+}
+
+func (g *Generator) partOfSpeech(target *pb.WordMeaning, pool []*pb.WordMeaning) *Question {
+	correct := strings.TrimSpace(target.GetPartOfSpeech())
+	if correct == "" {
+		return nil
+	}
+
+	seen := map[string]bool{correct: true}
+	var distractors []string
+	for _, wordMeaning := range pool {
+		partOfSpeech := strings.TrimSpace(wordMeaning.GetPartOfSpeech())
+		if partOfSpeech != "" && !seen[partOfSpeech] {
+			seen[partOfSpeech] = true
+			distractors = append(distractors, partOfSpeech)
+		}
+	}
+	for _, partOfSpeech := range partsOfSpeech {
+		if !seen[partOfSpeech] {
+			seen[partOfSpeech] = true
+			distractors = append(distractors, partOfSpeech)
+		}
+	}
+
+	ask := fmt.Sprintf("What part of speech is %q", target.GetWord())
+	if definition := strings.TrimSpace(target.GetDefinition()); definition != "" {
+		ask += fmt.Sprintf(" when it means %q", definition)
+	}
+	return g.choose(ask+"?", correct, distractors)
+}
+
+// wordDistractors returns the distinct headwords of the pool other than the
+// target's, those sharing its part of speech first.
+func (g *Generator) wordDistractors(target *pb.WordMeaning, pool []*pb.WordMeaning) []string {
+	word := strings.ToLower(target.GetWord())
+	seen := map[string]bool{word: true}
+
+	var same, other []string
+	for _, i := range g.Rand.Perm(len(pool)) {
+		candidate := strings.ToLower(strings.TrimSpace(pool[i].GetWord()))
+		if candidate == "" || seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		if pool[i].GetPartOfSpeech() == target.GetPartOfSpeech() {
+			same = append(same, candidate)
+		} else {
+			other = append(other, candidate)
+		}
+	}
+	return append(same, other...)
+}
+
+// choose assembles a question from the first distractors, in shuffled order.
+// It returns nil when there is no distractor at all.
+func (g *Generator) choose(ask, correct string, distractors []string) *Question {
+	if len(distractors) == 0 {
+		return nil
+	}
+	n := min(max(g.AnswerCount-1, 1), len(distractors))
+
+	answers := append([]string{correct}, distractors[:n]...)
+	g.Rand.Shuffle(len(answers), func(i, j int) {
+		answers[i], answers[j] = answers[j], answers[i]
+	})
+	return &Question{
+		Ask:           ask,
+		Answers:       answers,
+		CorrectAnswer: correct,
+	}
+}
+
+// wordPattern matches word, and forms of it built by adding a suffix, as a
+// whole word.
+func wordPattern(word string) *regexp.Regexp {
+	word = strings.TrimSpace(word)
+	if word == "" {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(word) + `[a-z]*\b`)
+}
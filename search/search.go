@@ -0,0 +1,309 @@
+// Package search runs full-text searches over the definitions and example
+// sentences of dictionary word meanings, using an in-memory inverted index.
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/lemma"
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// Fields a Highlight may come from.
+const (
+	FieldDefinition = "definition"
+	FieldExample    = "example"
+)
+
+// A term found in a definition counts for this many found in an example.
+const definitionWeight = 3
+
+// BM25 parameters.
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Span is a match within a text, in Unicode code points, end exclusive.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Highlight is a text of a word meaning with the spans matching a query.
+type Highlight struct {
+	Field string
+	Text  string
+	Spans []Span
+}
+
+// Result is a word meaning matching a query.
+type Result struct {
+	WordMeaning *pb.WordMeaning
+	Score       float64
+	Highlights  []Highlight
+}
+
+type document struct {
+	wordMeaning *pb.WordMeaning
+	// length is the weighted number of terms of the document.
+	length int
+}
+
+// Index is an inverted index of word meanings. It is safe for concurrent use.
+type Index struct {
+	mu          sync.RWMutex
+	documents   map[string]*document
+	postings    map[string]map[string]int
+	totalLength int
+}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{
+		documents: make(map[string]*document),
+		postings:  make(map[string]map[string]int),
+	}
+}
+
+// Add indexes wordMeaning under its id, replacing any earlier version.
+func (i *Index) Add(wordMeaning *pb.WordMeaning) {
+	id := wordMeaning.GetId()
+	if id == "" {
+		return
+	}
+
+	frequencies := make(map[string]int)
+	for _, term := range terms(wordMeaning.GetDefinition()) {
+		frequencies[term.text] += definitionWeight
+	}
+	for _, text := range exampleTexts(wordMeaning) {
+		for _, term := range terms(text) {
+			frequencies[term.text]++
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(id)
+	doc := &document{
+		wordMeaning: proto.Clone(wordMeaning).(*pb.WordMeaning),
+	}
+	for term, frequency := range frequencies {
+		if i.postings[term] == nil {
+			i.postings[term] = make(map[string]int)
+		}
+		i.postings[term][id] = frequency
+		doc.length += frequency
+	}
+	i.documents[id] = doc
+	i.totalLength += doc.length
+}
+
+// Remove drops the word meaning id from the index.
+func (i *Index) Remove(id string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(id)
+}
+
+func (i *Index) remove(id string) {
+	doc, ok := i.documents[id]
+	if !ok {
+		return
+	}
+	for term, docs := range i.postings {
+		if _, ok := docs[id]; ok {
+			delete(docs, id)
+			if len(docs) == 0 {
+				delete(i.postings, term)
+			}
+		}
+	}
+	i.totalLength -= doc.length
+	delete(i.documents, id)
+}
+
+// Search returns up to limit word meanings matching query, best first. Each
+// query term also matches the inflected forms of the word and the headwords
+// it may be a form of.
+func (i *Index) Search(query string, limit int) []Result {
+	queryTerms := expand(terms(query))
+	if len(queryTerms) == 0 || limit <= 0 {
+		return nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if len(i.documents) == 0 {
+		return nil
+	}
+	averageLength := float64(i.totalLength) / float64(len(i.documents))
+
+	scores := make(map[string]float64)
+	for _, alternatives := range queryTerms {
+		// A query term scores once per document, through its best matching
+		// alternative.
+		best := make(map[string]float64)
+		for _, term := range alternatives {
+			docs := i.postings[term]
+			if len(docs) == 0 {
+				continue
+			}
+			n := float64(len(docs))
+			idf := math.Log(1 + (float64(len(i.documents))-n+0.5)/(n+0.5))
+			for id, frequency := range docs {
+				tf := float64(frequency)
+				norm := 1 - b + b*float64(i.documents[id].length)/averageLength
+				best[id] = max(best[id], idf*tf*(k1+1)/(tf+k1*norm))
+			}
+		}
+		for id, score := range best {
+			scores[id] += score
+		}
+	}
+
+	ids := make([]string, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(a, b int) bool {
+		if scores[ids[a]] != scores[ids[b]] {
+			return scores[ids[a]] > scores[ids[b]]
+		}
+		return ids[a] < ids[b]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	matching := make(map[string]bool)
+	for _, alternatives := range queryTerms {
+		for _, term := range alternatives {
+			matching[term] = true
+		}
+	}
+	results := make([]Result, 0, len(ids))
+	for _, id := range ids {
+		wordMeaning := i.documents[id].wordMeaning
+		results = append(results, Result{
+			WordMeaning: proto.Clone(wordMeaning).(*pb.WordMeaning),
+			Score:       scores[id],
+			Highlights:  highlights(wordMeaning, matching),
+		})
+	}
+	return results
+}
+
+func highlights(wordMeaning *pb.WordMeaning, matching map[string]bool) []Highlight {
+	var found []Highlight
+	add := func(field, text string) {
+		var spans []Span
+		for _, term := range terms(text) {
+			if matching[term.text] {
+				spans = append(spans, term.span)
+			}
+		}
+		if len(spans) > 0 {
+			found = append(found, Highlight{Field: field, Text: text, Spans: spans})
+		}
+	}
+
+	add(FieldDefinition, wordMeaning.GetDefinition())
+	for _, text := range exampleTexts(wordMeaning) {
+		add(FieldExample, text)
+	}
+	return found
+}
+
+func exampleTexts(wordMeaning *pb.WordMeaning) []string {
+	var texts []string
+	for _, example := range wordMeaning.GetExamples() {
+		for _, sentence := range example.GetExamples() {
+			if sentence.GetText() != "" {
+				texts = append(texts, sentence.GetText())
+			}
+		}
+	}
+	return texts
+}
+
+type term struct {
+	text string
+	span Span
+}
+
+// terms splits text into lower-case words, leaving out stop words.
+func terms(text string) []term {
+	var found []term
+	start := -1
+	var word []rune
+	flush := func(end int) {
+		if len(word) > 0 {
+			// The span covers the word without the apostrophes trimmed
+			// off, as in "the dogs' bowls".
+			trimmed := strings.TrimLeft(string(word), "'")
+			start += len(word) - utf8.RuneCountInString(trimmed)
+			text := strings.TrimRight(trimmed, "'")
+			end -= utf8.RuneCountInString(trimmed) - utf8.RuneCountInString(text)
+			if text != "" && !stopWords[text] {
+				found = append(found, term{text: text, span: Span{Start: start, End: end}})
+			}
+		}
+		word = word[:0]
+		start = -1
+	}
+
+	n := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || (r == '\'' && len(word) > 0) {
+			if start < 0 {
+				start = n
+			}
+			word = append(word, unicode.ToLower(r))
+		} else {
+			flush(n)
+		}
+		n++
+	}
+	flush(n)
+	return found
+}
+
+// expand returns, for every distinct query term, the index terms it matches.
+func expand(queryTerms []term) [][]string {
+	seen := make(map[string]bool)
+	var expanded [][]string
+	for _, t := range queryTerms {
+		if seen[t.text] {
+			continue
+		}
+		seen[t.text] = true
+
+		alternatives := lemma.Forms(t.text, "")
+		for _, candidate := range lemma.Lemmas(t.text) {
+			alternatives = append(alternatives, lemma.Forms(candidate, "")...)
+		}
+		expanded = append(expanded, alternatives)
+	}
+	return expanded
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "is": true,
+	"it": true, "its": true, "of": true, "on": true, "or": true, "that": true,
+	"the": true, "this": true, "to": true, "was": true, "were": true,
+	"which": true, "with": true, "you": true, "your": true,
+	"someone": true, "something": true, "sb": true, "sth": true,
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/auth"
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// methodPermissions is what each WordService method needs the caller to be
+// allowed. A method missing here cannot be called when the server checks
+// permissions.
+var methodPermissions = map[string]auth.Permission{
+	"FindWordByDictionary":    auth.PermissionPublic,
+	"FindWordsByDictionary":   auth.PermissionPublic,
+	"StreamWordsByDictionary": auth.PermissionPublic,
+	"AutocompleteWords":       auth.PermissionPublic,
+	"SearchByDefinition":      auth.PermissionPublic,
+
+	"CreateFavoriteWordMeaning": auth.PermissionUser,
+	"DeleteFavoriteWordMeaning": auth.PermissionUser,
+	"FindFavoriteWordMeanings":  auth.PermissionUser,
+	"CreateExam":                auth.PermissionUser,
+	"UpdateExam":                auth.PermissionUser,
+	"DeleteExam":                auth.PermissionUser,
+	"FindExams":                 auth.PermissionUser,
+	"CreateQuestion":            auth.PermissionUser,
+	"UpdateQuestion":            auth.PermissionUser,
+	"DeleteQuestion":            auth.PermissionUser,
+	"FindQuestions":             auth.PermissionUser,
+	"GenerateQuestions":         auth.PermissionUser,
+	"CreateExamRecord":          auth.PermissionUser,
+	"FindExamRecords":           auth.PermissionUser,
+	"GetDueReviews":             auth.PermissionUser,
+	"SubmitReviewGrade":         auth.PermissionUser,
+
+	"CreateWordMeaning":        auth.PermissionEditDictionary,
+	"UpdateWordMeaning":        auth.PermissionEditDictionary,
+	"DeleteWordMeaning":        auth.PermissionEditDictionary,
+	"ListWordMeaningRevisions": auth.PermissionEditDictionary,
+	"RevertWordMeaning":        auth.PermissionEditDictionary,
+}
+
+// MethodPermission is the auth.Policy of the server: it returns what the
+// call of fullMethod, in the "/package.Service/Method" form, needs. Methods
+// of other services, such as health checks, are public.
+func MethodPermission(fullMethod string) (auth.Permission, bool) {
+	method, ok := strings.CutPrefix(fullMethod, "/"+pb.WordService_ServiceDesc.ServiceName+"/")
+	if !ok {
+		return auth.PermissionPublic, true
+	}
+	permission, ok := methodPermissions[method]
+	return permission, ok
+}
+
+// userID returns the user a call acts for. When the server authenticates
+// calls, that is the authenticated caller, and a user_id naming anyone else
+// is refused; otherwise it is the user_id of the request.
+func (s *WordService) userID(ctx context.Context, requested string) (string, error) {
+	userID, err := s.optionalUserID(ctx, requested)
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return userID, nil
+}
+
+// editorID is userID for dictionary edits, which are refused unless the
+// server authenticates calls: a user_id in the request proves nothing about
+// the caller's role.
+func (s *WordService) editorID(ctx context.Context, requested string) (string, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok || identity.Anonymous() {
+		return "", status.Error(codes.PermissionDenied, "dictionary editing requires authentication")
+	}
+	return s.userID(ctx, requested)
+}
+
+// optionalUserID is userID for calls that anonymous callers may make too; it
+// returns "" for them.
+func (s *WordService) optionalUserID(ctx context.Context, requested string) (string, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return requested, nil
+	}
+	if requested != "" && requested != identity.UserID {
+		return "", status.Error(codes.PermissionDenied, "user_id does not match the authenticated user")
+	}
+	return identity.UserID, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/auth"
+)
+
+func TestUserID(t *testing.T) {
+	unauthenticated := context.Background()
+	anonymous := auth.NewContext(context.Background(), auth.Identity{})
+	learner := auth.NewContext(context.Background(), auth.Identity{UserID: "u1", Role: auth.RoleLearner})
+	editor := auth.NewContext(context.Background(), auth.Identity{UserID: "e1", Role: auth.RoleEditor})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		requested string
+		// Results of userID, optionalUserID and editorID.
+		want, wantOptional, wantEditor             string
+		wantCode, wantOptionalCode, wantEditorCode codes.Code
+	}{
+		{
+			name:           "no authentication trusts the request",
+			ctx:            unauthenticated,
+			requested:      "u2",
+			want:           "u2",
+			wantOptional:   "u2",
+			wantEditorCode: codes.PermissionDenied,
+		},
+		{
+			name:           "no authentication and no user_id",
+			ctx:            unauthenticated,
+			wantCode:       codes.InvalidArgument,
+			wantEditorCode: codes.PermissionDenied,
+		},
+		{
+			name:           "anonymous caller",
+			ctx:            anonymous,
+			wantCode:       codes.InvalidArgument,
+			wantEditorCode: codes.PermissionDenied,
+		},
+		{
+			name:             "anonymous caller naming a user",
+			ctx:              anonymous,
+			requested:        "u1",
+			wantCode:         codes.PermissionDenied,
+			wantOptionalCode: codes.PermissionDenied,
+			wantEditorCode:   codes.PermissionDenied,
+		},
+		{
+			name:         "authenticated caller without user_id",
+			ctx:          learner,
+			want:         "u1",
+			wantOptional: "u1",
+			wantEditor:   "u1",
+		},
+		{
+			name:         "authenticated caller naming themselves",
+			ctx:          editor,
+			requested:    "e1",
+			want:         "e1",
+			wantOptional: "e1",
+			wantEditor:   "e1",
+		},
+		{
+			name:             "authenticated caller naming someone else",
+			ctx:              learner,
+			requested:        "u2",
+			wantCode:         codes.PermissionDenied,
+			wantOptionalCode: codes.PermissionDenied,
+			wantEditorCode:   codes.PermissionDenied,
+		},
+	}
+
+	s := New(NewMemorySource())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.userID(tt.ctx, tt.requested)
+			if status.Code(err) != tt.wantCode || got != tt.want {
+				t.Errorf("userID() = %q, %v, want %q, %v", got, err, tt.want, tt.wantCode)
+			}
+			got, err = s.optionalUserID(tt.ctx, tt.requested)
+			if status.Code(err) != tt.wantOptionalCode || got != tt.wantOptional {
+				t.Errorf("optionalUserID() = %q, %v, want %q, %v", got, err, tt.wantOptional, tt.wantOptionalCode)
+			}
+			got, err = s.editorID(tt.ctx, tt.requested)
+			if status.Code(err) != tt.wantEditorCode || got != tt.wantEditor {
+				t.Errorf("editorID() = %q, %v, want %q, %v", got, err, tt.wantEditor, tt.wantEditorCode)
+			}
+		})
+	}
+}
+
+func TestMethodPermission(t *testing.T) {
+	tests := []struct {
+		fullMethod string
+		want       auth.Permission
+		wantOK     bool
+	}{
+		{"/pb.WordService/FindWordByDictionary", auth.PermissionPublic, true},
+		{"/pb.WordService/CreateFavoriteWordMeaning", auth.PermissionUser, true},
+		{"/pb.WordService/UpdateWordMeaning", auth.PermissionEditDictionary, true},
+		{"/pb.WordService/RevertWordMeaning", auth.PermissionEditDictionary, true},
+		{"/pb.WordService/NoSuchMethod", 0, false},
+		{"/grpc.health.v1.Health/Check", auth.PermissionPublic, true},
+	}
+
+	for _, tt := range tests {
+		got, ok := MethodPermission(tt.fullMethod)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("MethodPermission(%q) = %v, %v, want %v, %v", tt.fullMethod, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+const (
+	defaultCompletionLimit = 10
+	maxCompletionLimit     = 50
+)
+
+func (s *WordService) AutocompleteWords(
+	_ context.Context,
+	req *pb.AutocompleteWordsRequest,
+) (*pb.AutocompleteWordsResponse, error) {
+	if s.autocomplete == nil {
+		return nil, status.Error(codes.FailedPrecondition, "autocomplete is not configured")
+	}
+	prefix := normalizeWord(req.GetPrefix())
+	if prefix == "" {
+		return nil, status.Error(codes.InvalidArgument, "prefix is required")
+	}
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultCompletionLimit
+	case limit > maxCompletionLimit:
+		limit = maxCompletionLimit
+	}
+
+	completions := s.autocomplete.Complete(prefix, limit)
+	pbCompletions := make([]*pb.WordCompletion, 0, len(completions))
+	for _, completion := range completions {
+		pbCompletions = append(pbCompletions, &pb.WordCompletion{
+			Word:          completion.Word,
+			PartsOfSpeech: completion.PartsOfSpeech,
+		})
+	}
+
+	return &pb.AutocompleteWordsResponse{
+		Completions: pbCompletions,
+	}, nil
+}
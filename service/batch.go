@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+const (
+	// maxBatchWords caps the words of one batch request.
+	maxBatchWords = 1000
+	// maxStreamWords caps the words of one streaming request.
+	maxStreamWords = 20000
+	// defaultLookupConcurrency is the number of words of a batch looked up at
+	// the same time.
+	defaultLookupConcurrency = 8
+)
+
+func (s *WordService) FindWordsByDictionary(
+	ctx context.Context,
+	req *pb.FindWordsByDictionaryRequest,
+) (*pb.FindWordsByDictionaryResponse, error) {
+	words, err := batchWords(req.GetWords(), maxBatchWords)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := s.optionalUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.WordResult, len(words))
+	sem := make(chan struct{}, s.lookupConcurrency)
+	var wg sync.WaitGroup
+	for i, word := range words {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+
+		wg.Add(1)
+		go func(i int, word string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = s.wordResult(ctx, word, userID)
+		}(i, word)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	return &pb.FindWordsByDictionaryResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *WordService) StreamWordsByDictionary(
+	req *pb.FindWordsByDictionaryRequest,
+	stream pb.WordService_StreamWordsByDictionaryServer,
+) error {
+	words, err := batchWords(req.GetWords(), maxStreamWords)
+	if err != nil {
+		return err
+	}
+	userID, err := s.optionalUserID(stream.Context(), req.GetUserId())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Workers hand results to this goroutine, which alone sends on the
+	// stream. A slow client blocks Send, which blocks the workers, which
+	// stops new lookups from starting.
+	results := make(chan *pb.WordResult)
+	go func() {
+		defer close(results)
+
+		sem := make(chan struct{}, s.lookupConcurrency)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		for _, word := range words {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			wg.Add(1)
+			go func(word string) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				result := s.wordResult(ctx, word, userID)
+				select {
+				case results <- result:
+				case <-ctx.Done():
+				}
+			}(word)
+		}
+	}()
+
+	for result := range results {
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// batchWords normalizes and de-duplicates the words of a batch request,
+// keeping the order in which they first appear. Blank words are kept so
+// they get an error result.
+func batchWords(words []string, limit int) ([]string, error) {
+	if len(words) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "words is required")
+	}
+	if len(words) > limit {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"at most %d words can be looked up at once", limit)
+	}
+
+	seen := make(map[string]bool, len(words))
+	distinct := make([]string, 0, len(words))
+	for _, word := range words {
+		word = normalizeWord(word)
+		if !seen[word] {
+			seen[word] = true
+			distinct = append(distinct, word)
+		}
+	}
+	return distinct, nil
+}
+
+// wordResult looks up one word of a batch, reporting failure in the result.
+func (s *WordService) wordResult(ctx context.Context, word, userID string) *pb.WordResult {
+	result := &pb.WordResult{
+		Word: word,
+	}
+
+	var err error
+	if word == "" {
+		err = status.Error(codes.InvalidArgument, "word is required")
+	} else {
+		result.Response, err = s.findWord(ctx, word, userID)
+	}
+	if err != nil {
+		st := status.Convert(err)
+		result.Error = &pb.LookupError{
+			Code:    int32(st.Code()),
+			Message: st.Message(),
+		}
+	}
+	return result
+}
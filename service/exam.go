@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+func (s *WordService) CreateExam(
+	ctx context.Context,
+	req *pb.CreateExamRequest,
+) (*pb.CreateExamResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	topic := strings.TrimSpace(req.GetTopic())
+	if topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	exam, err := s.store.CreateExam(ctx, &store.Exam{
+		UserID:      userID,
+		Topic:       topic,
+		Description: strings.TrimSpace(req.GetDescription()),
+		IsPublic:    req.GetIsPublic(),
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.CreateExamResponse{
+		Exam: examToPB(exam),
+	}, nil
+}
+
+func (s *WordService) UpdateExam(
+	ctx context.Context,
+	req *pb.UpdateExamRequest,
+) (*pb.UpdateExamResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExamId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
+	}
+	topic := strings.TrimSpace(req.GetTopic())
+	if topic == "" {
+		return nil, status.Error(codes.InvalidArgument, "topic is required")
+	}
+
+	exam, err := s.store.UpdateExam(ctx, &store.Exam{
+		ID:          req.GetExamId(),
+		UserID:      userID,
+		Topic:       topic,
+		Description: strings.TrimSpace(req.GetDescription()),
+		IsPublic:    req.GetIsPublic(),
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.UpdateExamResponse{
+		Exam: examToPB(exam),
+	}, nil
+}
+
+func (s *WordService) DeleteExam(
+	ctx context.Context,
+	req *pb.DeleteExamRequest,
+) (*pb.DeleteExamResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExamId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
+	}
+
+	if err := s.store.DeleteExam(ctx, userID, req.GetExamId()); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.DeleteExamResponse{}, nil
+}
+
+func (s *WordService) FindExams(
+	ctx context.Context,
+	req *pb.FindExamsRequest,
+) (*pb.FindExamsResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	exams, next, total, err := s.store.FindExams(ctx, store.ExamQuery{
+		UserID:        userID,
+		IncludePublic: req.GetIncludePublic(),
+		Page: store.Page{
+			Size:  int(req.GetPageSize()),
+			Token: req.GetPageToken(),
+		},
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	pbExams := make([]*pb.Exam, 0, len(exams))
+	for _, exam := range exams {
+		pbExams = append(pbExams, examToPB(exam))
+	}
+
+	return &pb.FindExamsResponse{
+		Exams:         pbExams,
+		NextPageToken: next,
+		Total:         total,
+	}, nil
+}
+
+func examToPB(exam *store.Exam) *pb.Exam {
+	return &pb.Exam{
+		Id:          exam.ID,
+		Topic:       exam.Topic,
+		Description: exam.Description,
+		IsPublic:    exam.IsPublic,
+		UserId:      exam.UserID,
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+func (s *WordService) CreateExamRecord(
+	ctx context.Context,
+	req *pb.CreateExamRecordRequest,
+) (*pb.CreateExamRecordResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExamId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
+	}
+	if req.StartedAt != nil {
+		if err := req.GetStartedAt().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid started_at: %v", err)
+		}
+	}
+
+	questions, err := s.store.ExamQuestions(ctx, userID, req.GetExamId())
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	if len(questions) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "exam has no questions")
+	}
+
+	questionIDs := make(map[string]bool, len(questions))
+	for _, question := range questions {
+		questionIDs[question.ID] = true
+	}
+	answers := make(map[string]string, len(req.GetAnswers()))
+	for _, answer := range req.GetAnswers() {
+		questionID := answer.GetQuestionId()
+		if !questionIDs[questionID] {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"question %q does not belong to the exam", questionID)
+		}
+		if _, ok := answers[questionID]; ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"question %q answered more than once", questionID)
+		}
+		answers[questionID] = strings.TrimSpace(answer.GetAnswer())
+	}
+
+	record := &store.ExamRecord{
+		ExamID:        req.GetExamId(),
+		UserID:        userID,
+		QuestionCount: int32(len(questions)),
+	}
+	if req.StartedAt != nil {
+		record.StartedAt = req.GetStartedAt().AsTime()
+	}
+	for _, question := range questions {
+		answer := answers[question.ID]
+		result := store.QuestionResult{
+			QuestionID:    question.ID,
+			Answer:        answer,
+			CorrectAnswer: question.CorrectAnswer,
+			IsCorrect:     answer != "" && answer == question.CorrectAnswer,
+		}
+		if result.IsCorrect {
+			record.CorrectCount++
+		}
+		record.Results = append(record.Results, result)
+	}
+	record.Score = score(record.CorrectCount, record.QuestionCount)
+
+	record, err = s.store.CreateExamRecord(ctx, record)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.CreateExamRecordResponse{
+		ExamRecord: examRecordToPB(record),
+	}, nil
+}
+
+func (s *WordService) FindExamRecords(
+	ctx context.Context,
+	req *pb.FindExamRecordsRequest,
+) (*pb.FindExamRecordsResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExamId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	if _, err := s.store.FindExam(ctx, userID, req.GetExamId()); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	records, next, total, err := s.store.FindExamRecords(ctx, store.ExamRecordQuery{
+		UserID: userID,
+		ExamID: req.GetExamId(),
+		Page: store.Page{
+			Size:  int(req.GetPageSize()),
+			Token: req.GetPageToken(),
+		},
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	scores, err := s.store.ExamScores(ctx, userID, req.GetExamId())
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	pbRecords := make([]*pb.ExamRecord, 0, len(records))
+	for _, record := range records {
+		pbRecords = append(pbRecords, examRecordToPB(record))
+	}
+
+	return &pb.FindExamRecordsResponse{
+		ExamRecords:   pbRecords,
+		NextPageToken: next,
+		Total:         total,
+		Trend:         scoreTrend(scores),
+	}, nil
+}
+
+// score returns the percentage of correct answers, rounded to the nearest
+// integer.
+func score(correct, total int32) int32 {
+	if total == 0 {
+		return 0
+	}
+	return (correct*200 + total) / (total * 2)
+}
+
+// scoreTrend summarizes attempts, which must be sorted oldest first.
+func scoreTrend(records []*store.ExamRecord) *pb.ExamScoreTrend {
+	trend := &pb.ExamScoreTrend{
+		Attempts: int32(len(records)),
+	}
+	if len(records) == 0 {
+		return trend
+	}
+
+	var sum int64
+	for _, record := range records {
+		sum += int64(record.Score)
+		trend.BestScore = max(trend.BestScore, record.Score)
+		trend.Points = append(trend.Points, &pb.ExamScorePoint{
+			ExamRecordId: record.ID,
+			Score:        record.Score,
+			FinishedAt:   timestamppb.New(record.CreatedAt),
+		})
+	}
+	trend.FirstScore = records[0].Score
+	trend.LatestScore = records[len(records)-1].Score
+	trend.AverageScore = float64(sum) / float64(len(records))
+	return trend
+}
+
+func examRecordToPB(record *store.ExamRecord) *pb.ExamRecord {
+	results := make([]*pb.QuestionResult, 0, len(record.Results))
+	for _, result := range record.Results {
+		results = append(results, &pb.QuestionResult{
+			QuestionId:    result.QuestionID,
+			Answer:        result.Answer,
+			CorrectAnswer: result.CorrectAnswer,
+			IsCorrect:     result.IsCorrect,
+		})
+	}
+
+	return &pb.ExamRecord{
+		Id:            record.ID,
+		ExamId:        record.ExamID,
+		UserId:        record.UserID,
+		Score:         record.Score,
+		CorrectCount:  record.CorrectCount,
+		QuestionCount: record.QuestionCount,
+		Results:       results,
+		StartedAt:     timestamppb.New(record.StartedAt),
+		FinishedAt:    timestamppb.New(record.CreatedAt),
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+func (s *WordService) CreateFavoriteWordMeaning(
+	ctx context.Context,
+	req *pb.CreateFavoriteWordMeaningRequest,
+) (*pb.CreateFavoriteWordMeaningResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetWordMeaningId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning_id is required")
+	}
+
+	favorite, err := s.store.CreateFavorite(ctx, userID, req.GetWordMeaningId())
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.CreateFavoriteWordMeaningResponse{
+		FavoriteWordMeaningId: favorite.ID,
+	}, nil
+}
+
+func (s *WordService) DeleteFavoriteWordMeaning(
+	ctx context.Context,
+	req *pb.DeleteFavoriteWordMeaningRequest,
+) (*pb.DeleteFavoriteWordMeaningResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetFavoriteWordMeaningId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "favorite_word_meaning_id is required")
+	}
+
+	err = s.store.DeleteFavorite(ctx, userID, req.GetFavoriteWordMeaningId())
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.DeleteFavoriteWordMeaningResponse{}, nil
+}
+
+func (s *WordService) FindFavoriteWordMeanings(
+	ctx context.Context,
+	req *pb.FindFavoriteWordMeaningsRequest,
+) (*pb.FindFavoriteWordMeaningsResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	favorites, next, total, err := s.store.FindFavorites(ctx, store.FavoriteQuery{
+		UserID: userID,
+		Word:   normalizeWord(req.GetWord()),
+		Page: store.Page{
+			Size:  int(req.GetPageSize()),
+			Token: req.GetPageToken(),
+		},
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	wordMeanings := make([]*pb.WordMeaning, 0, len(favorites))
+	for _, favorite := range favorites {
+		wordMeaning, err := s.store.FindWordMeaning(ctx, favorite.WordMeaningID)
+		if err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		wordMeaning.FavoriteWordMeaningId = favorite.ID
+		wordMeanings = append(wordMeanings, wordMeaning)
+	}
+
+	return &pb.FindFavoriteWordMeaningsResponse{
+		WordMeanings:  wordMeanings,
+		NextPageToken: next,
+		Total:         total,
+	}, nil
+}
+
+// fillFavoriteIDs sets FavoriteWordMeaningId on the meanings userID has saved.
+func (s *WordService) fillFavoriteIDs(
+	ctx context.Context,
+	userID string,
+	wordMeanings []*pb.WordMeaning,
+) error {
+	ids := make([]string, 0, len(wordMeanings))
+	for _, wordMeaning := range wordMeanings {
+		ids = append(ids, wordMeaning.GetId())
+	}
+
+	favoriteIDs, err := s.store.FavoriteIDs(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+	for _, wordMeaning := range wordMeanings {
+		wordMeaning.FavoriteWordMeaningId = favoriteIDs[wordMeaning.GetId()]
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// maxLineSize caps one entry of a JSONL dump.
+const maxLineSize = 4 << 20
+
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// LoadFileSource returns a MemorySource holding the entries of a local dump,
+// so the service can run without any network access. The dump holds
+// WordMeaning messages in their protojson form, either as a JSON array or
+// one per line (JSONL). A path ending in ".gz" is read through gzip.
+func LoadFileSource(path string) (*MemorySource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	wordMeanings, err := ReadWordMeanings(r)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	source := NewMemorySource()
+	if err := source.Add(wordMeanings...); err != nil {
+		return nil, fmt.Errorf("load %s: %w", path, err)
+	}
+	return source, nil
+}
+
+// ReadWordMeanings decodes WordMeaning messages written as a JSON array or as
+// JSONL, telling the two apart by the first non-blank character.
+func ReadWordMeanings(r io.Reader) ([]*pb.WordMeaning, error) {
+	br := bufio.NewReader(r)
+	for {
+		c, err := br.ReadByte()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			continue
+		}
+		if err := br.UnreadByte(); err != nil {
+			return nil, err
+		}
+		if c == '[' {
+			return readJSONArray(br)
+		}
+		return readJSONLines(br)
+	}
+}
+
+func readJSONArray(r io.Reader) ([]*pb.WordMeaning, error) {
+	var raws []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raws); err != nil {
+		return nil, err
+	}
+
+	wordMeanings := make([]*pb.WordMeaning, 0, len(raws))
+	for i, raw := range raws {
+		wordMeaning := &pb.WordMeaning{}
+		if err := unmarshalOptions.Unmarshal(raw, wordMeaning); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		wordMeanings = append(wordMeanings, wordMeaning)
+	}
+	return wordMeanings, nil
+}
+
+func readJSONLines(r io.Reader) ([]*pb.WordMeaning, error) {
+	var wordMeanings []*pb.WordMeaning
+	err := ScanJSONLines(r, func(line int, raw []byte) error {
+		wordMeaning := &pb.WordMeaning{}
+		if err := unmarshalOptions.Unmarshal(raw, wordMeaning); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		wordMeanings = append(wordMeanings, wordMeaning)
+		return nil
+	})
+	return wordMeanings, err
+}
+
+// ScanJSONLines calls fn with every non-blank line of r and its 1-based
+// number, stopping at the first error fn returns.
+func ScanJSONLines(r io.Reader, fn func(line int, raw []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		if err := fn(line, raw); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/quiz"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+const (
+	defaultAnswerCount = 4
+	// maxGenerateTargets caps the word meanings of one GenerateQuestions call.
+	maxGenerateTargets = 100
+	// distractorPoolSize is the number of saved word meanings distractors are
+	// drawn from, on top of the targets themselves.
+	distractorPoolSize = 200
+)
+
+var allQuestionTypes = []pb.QuestionType{
+	pb.QuestionType_QUESTION_TYPE_DEFINITION,
+	pb.QuestionType_QUESTION_TYPE_CLOZE,
+	pb.QuestionType_QUESTION_TYPE_PART_OF_SPEECH,
+}
+
+func (s *WordService) GenerateQuestions(
+	ctx context.Context,
+	req *pb.GenerateQuestionsRequest,
+) (*pb.GenerateQuestionsResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	answerCount := int(req.GetAnswerCount())
+	if answerCount == 0 {
+		answerCount = defaultAnswerCount
+	}
+	if answerCount < minAnswers || answerCount > maxAnswers {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"answer_count must be between %d and %d", minAnswers, maxAnswers)
+	}
+	types := req.GetTypes()
+	if len(types) == 0 {
+		types = allQuestionTypes
+	}
+	for _, t := range types {
+		if _, ok := pb.QuestionType_name[int32(t)]; !ok || t == pb.QuestionType_QUESTION_TYPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported question type %v", t)
+		}
+	}
+
+	if examID := req.GetExamId(); examID != "" {
+		exam, err := s.store.FindExam(ctx, userID, examID)
+		if err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		if exam.UserID != userID {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+	}
+
+	targets, err := s.generateTargets(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := s.store.SampleWordMeanings(ctx, distractorPoolSize)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	pool = append(pool, targets...)
+
+	generator := &quiz.Generator{
+		AnswerCount: answerCount,
+		Rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	var questions []*pb.Question
+	for _, target := range targets {
+		for _, generated := range generator.Generate(target, pool, types) {
+			question := &store.Question{
+				ExamID:        req.GetExamId(),
+				UserID:        userID,
+				Type:          generated.Type,
+				Ask:           generated.Ask,
+				Answers:       generated.Answers,
+				CorrectAnswer: generated.CorrectAnswer,
+				WordMeaningID: generated.WordMeaningID,
+			}
+			if question.ExamID != "" {
+				question, err = s.store.CreateQuestion(ctx, question)
+				if err != nil {
+					return nil, s.storeError(ctx, err)
+				}
+			}
+			questions = append(questions, questionToPB(question, true))
+		}
+	}
+
+	return &pb.GenerateQuestionsResponse{
+		Questions: questions,
+	}, nil
+}
+
+// generateTargets resolves the word meanings questions are generated for.
+func (s *WordService) generateTargets(
+	ctx context.Context,
+	userID string,
+	req *pb.GenerateQuestionsRequest,
+) ([]*pb.WordMeaning, error) {
+	ids := req.GetWordMeaningIds()
+	if req.GetFromFavorites() {
+		favorites, _, _, err := s.store.FindFavorites(ctx, store.FavoriteQuery{
+			UserID: userID,
+			Page:   store.Page{Size: maxGenerateTargets},
+		})
+		if err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		for _, favorite := range favorites {
+			ids = append(ids, favorite.WordMeaningID)
+		}
+	}
+
+	seen := make(map[string]bool, len(ids))
+	var targets []*pb.WordMeaning
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if len(targets) == maxGenerateTargets {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"at most %d word meanings can be used at once", maxGenerateTargets)
+		}
+
+		wordMeaning, err := s.store.FindWordMeaning(ctx, id)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "word meaning %q not found", id)
+		}
+		if err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		targets = append(targets, wordMeaning)
+	}
+
+	if len(targets) == 0 {
+		return nil, status.Error(codes.InvalidArgument,
+			"word_meaning_ids or favorites are required")
+	}
+	return targets, nil
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/kakurineuin/learn-english-word/dictdb"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+// PersistentSource is a DictionarySource that keeps every word resolved by
+// an upstream source in a dictdb file, and answers later lookups of the word
+// from the file alone. Words not found upstream are not kept, so they are
+// asked for again.
+type PersistentSource struct {
+	upstream DictionarySource
+	db       *dictdb.DB
+
+	// editMu serializes edits, which read an entry before writing it back.
+	editMu sync.Mutex
+}
+
+// NewPersistentSource returns a PersistentSource in front of upstream.
+func NewPersistentSource(upstream DictionarySource, db *dictdb.DB) *PersistentSource {
+	return &PersistentSource{
+		upstream: upstream,
+		db:       db,
+	}
+}
+
+func (p *PersistentSource) FindWord(ctx context.Context, word string) ([]*pb.WordMeaning, error) {
+	wordMeanings, found, err := p.db.Get(word)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return wordMeanings, nil
+	}
+
+	wordMeanings, err = p.upstream.FindWord(ctx, word)
+	if err != nil || len(wordMeanings) == 0 {
+		return wordMeanings, err
+	}
+	for _, wordMeaning := range wordMeanings {
+		FillQueryByWords(wordMeaning, word)
+	}
+	// An edit of the word may have been stored while upstream was asked;
+	// it wins over what upstream returned.
+	return p.db.PutIfAbsent(word, wordMeanings)
+}
+
+func (p *PersistentSource) FindWordMeaningByID(_ context.Context, id string) (*pb.WordMeaning, error) {
+	_, i, wordMeanings, err := p.locate(id)
+	if err != nil {
+		return nil, err
+	}
+	return wordMeanings[i], nil
+}
+
+// PutWordMeaning changes the entries kept in the file, which from then on
+// take precedence over those of upstream. A new headword is first resolved
+// upstream, so its other meanings are kept.
+func (p *PersistentSource) PutWordMeaning(ctx context.Context, wordMeaning *pb.WordMeaning) error {
+	p.editMu.Lock()
+	defer p.editMu.Unlock()
+
+	headword, i, wordMeanings, err := p.locate(wordMeaning.GetId())
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		if headword != wordMeaning.GetWord() {
+			if err := p.db.Put(headword, slices.Delete(wordMeanings, i, i+1)); err != nil {
+				return err
+			}
+		}
+	}
+
+	word := wordMeaning.GetWord()
+	wordMeanings, err = p.FindWord(ctx, word)
+	if err != nil {
+		return err
+	}
+	wordMeanings = slices.DeleteFunc(wordMeanings, func(existing *pb.WordMeaning) bool {
+		return existing.GetId() == wordMeaning.GetId()
+	})
+	wordMeanings = append(wordMeanings, wordMeaning)
+	sortWordMeanings(wordMeanings)
+	return p.db.Put(word, wordMeanings)
+}
+
+// DeleteWordMeaning removes an entry from the file. A headword left without
+// entries stays recorded as such, so it is not resolved upstream again.
+func (p *PersistentSource) DeleteWordMeaning(_ context.Context, id string) error {
+	p.editMu.Lock()
+	defer p.editMu.Unlock()
+
+	headword, i, wordMeanings, err := p.locate(id)
+	if err != nil {
+		return err
+	}
+	return p.db.Put(headword, slices.Delete(wordMeanings, i, i+1))
+}
+
+// locate returns the headword, entries and index among them of the word
+// meaning id.
+func (p *PersistentSource) locate(id string) (string, int, []*pb.WordMeaning, error) {
+	headword, ok, err := p.db.Headword(id)
+	if err != nil {
+		return "", -1, nil, err
+	}
+	if !ok {
+		return "", -1, nil, store.ErrNotFound
+	}
+	wordMeanings, _, err := p.db.Get(headword)
+	if err != nil {
+		return "", -1, nil, err
+	}
+	i := slices.IndexFunc(wordMeanings, func(wordMeaning *pb.WordMeaning) bool {
+		return wordMeaning.GetId() == id
+	})
+	if i < 0 {
+		return "", -1, nil, store.ErrNotFound
+	}
+	return headword, i, wordMeanings, nil
+}
+
+// WalkWordMeanings walks the entries of the file, then those of upstream,
+// if it can be walked, that the file does not hold.
+func (p *PersistentSource) WalkWordMeanings(
+	ctx context.Context,
+	fn func(*pb.WordMeaning) error,
+) error {
+	stored := make(map[string]bool)
+	err := p.db.Walk(func(headword string, wordMeanings []*pb.WordMeaning) error {
+		stored[headword] = true
+		for _, wordMeaning := range wordMeanings {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(wordMeaning); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	walker, ok := p.upstream.(DictionaryWalker)
+	if !ok {
+		return nil
+	}
+	return walker.WalkWordMeanings(ctx, func(wordMeaning *pb.WordMeaning) error {
+		if stored[normalizeWord(wordMeaning.GetWord())] {
+			return nil
+		}
+		return fn(wordMeaning)
+	})
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+const (
+	minAnswers = 2
+	maxAnswers = 10
+)
+
+func (s *WordService) CreateQuestion(
+	ctx context.Context,
+	req *pb.CreateQuestionRequest,
+) (*pb.CreateQuestionResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExamId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
+	}
+	question, err := s.newQuestion(ctx, req.GetAsk(), req.GetAnswers(),
+		req.GetCorrectAnswer(), req.GetWordMeaningId())
+	if err != nil {
+		return nil, err
+	}
+	question.UserID = userID
+	question.ExamID = req.GetExamId()
+
+	question, err = s.store.CreateQuestion(ctx, question)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.CreateQuestionResponse{
+		Question: questionToPB(question, true),
+	}, nil
+}
+
+func (s *WordService) UpdateQuestion(
+	ctx context.Context,
+	req *pb.UpdateQuestionRequest,
+) (*pb.UpdateQuestionResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetQuestionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "question_id is required")
+	}
+	question, err := s.newQuestion(ctx, req.GetAsk(), req.GetAnswers(),
+		req.GetCorrectAnswer(), req.GetWordMeaningId())
+	if err != nil {
+		return nil, err
+	}
+	question.ID = req.GetQuestionId()
+	question.UserID = userID
+
+	question, err = s.store.UpdateQuestion(ctx, question)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.UpdateQuestionResponse{
+		Question: questionToPB(question, true),
+	}, nil
+}
+
+func (s *WordService) DeleteQuestion(
+	ctx context.Context,
+	req *pb.DeleteQuestionRequest,
+) (*pb.DeleteQuestionResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetQuestionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "question_id is required")
+	}
+
+	if err := s.store.DeleteQuestion(ctx, userID, req.GetQuestionId()); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	return &pb.DeleteQuestionResponse{}, nil
+}
+
+func (s *WordService) FindQuestions(
+	ctx context.Context,
+	req *pb.FindQuestionsRequest,
+) (*pb.FindQuestionsResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExamId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "exam_id is required")
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	questions, next, total, err := s.store.FindQuestions(ctx, store.QuestionQuery{
+		UserID: userID,
+		ExamID: req.GetExamId(),
+		Page: store.Page{
+			Size:  int(req.GetPageSize()),
+			Token: req.GetPageToken(),
+		},
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	pbQuestions := make([]*pb.Question, 0, len(questions))
+	for _, question := range questions {
+		isOwner := question.UserID == userID
+		pbQuestions = append(pbQuestions, questionToPB(question, isOwner))
+	}
+
+	return &pb.FindQuestionsResponse{
+		Questions:     pbQuestions,
+		NextPageToken: next,
+		Total:         total,
+	}, nil
+}
+
+// newQuestion validates the content of a question. The correct answer must be
+// one of the answers, and answers must be distinct.
+func (s *WordService) newQuestion(
+	ctx context.Context,
+	ask string,
+	answers []string,
+	correctAnswer string,
+	wordMeaningID string,
+) (*store.Question, error) {
+	ask = strings.TrimSpace(ask)
+	if ask == "" {
+		return nil, status.Error(codes.InvalidArgument, "ask is required")
+	}
+	if len(answers) < minAnswers || len(answers) > maxAnswers {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"answers must have between %d and %d entries", minAnswers, maxAnswers)
+	}
+
+	trimmed := make([]string, 0, len(answers))
+	for _, answer := range answers {
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			return nil, status.Error(codes.InvalidArgument, "answers must not be empty")
+		}
+		if slices.Contains(trimmed, answer) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate answer %q", answer)
+		}
+		trimmed = append(trimmed, answer)
+	}
+
+	correctAnswer = strings.TrimSpace(correctAnswer)
+	if !slices.Contains(trimmed, correctAnswer) {
+		return nil, status.Error(codes.InvalidArgument, "correct_answer must be one of answers")
+	}
+
+	if wordMeaningID != "" {
+		_, err := s.store.FindWordMeaning(ctx, wordMeaningID)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, status.Errorf(codes.InvalidArgument, "word meaning %q not found", wordMeaningID)
+		}
+		if err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+	}
+
+	return &store.Question{
+		Ask:           ask,
+		Answers:       trimmed,
+		CorrectAnswer: correctAnswer,
+		WordMeaningID: wordMeaningID,
+	}, nil
+}
+
+// questionToPB converts a question, leaving out the correct answer unless the
+// caller owns the exam.
+func questionToPB(question *store.Question, withCorrectAnswer bool) *pb.Question {
+	q := &pb.Question{
+		Id:            question.ID,
+		ExamId:        question.ExamID,
+		Type:          question.Type,
+		Ask:           question.Ask,
+		Answers:       slices.Clone(question.Answers),
+		WordMeaningId: question.WordMeaningID,
+		UserId:        question.UserID,
+	}
+	if withCorrectAnswer {
+		q.CorrectAnswer = question.CorrectAnswer
+	}
+	return q
+}
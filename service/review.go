@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/srs"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+const (
+	defaultReviewLimit = 20
+	maxReviewLimit     = 100
+)
+
+func (s *WordService) GetDueReviews(
+	ctx context.Context,
+	req *pb.GetDueReviewsRequest,
+) (*pb.GetDueReviewsResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultReviewLimit
+	case limit > maxReviewLimit:
+		limit = maxReviewLimit
+	}
+
+	reviews, total, err := s.store.FindDueReviews(ctx, userID, s.now(), limit)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	pbReviews := make([]*pb.Review, 0, len(reviews))
+	for _, review := range reviews {
+		pbReview, err := s.reviewToPB(ctx, review)
+		if err != nil {
+			return nil, err
+		}
+		pbReviews = append(pbReviews, pbReview)
+	}
+
+	return &pb.GetDueReviewsResponse{
+		Reviews:  pbReviews,
+		TotalDue: total,
+	}, nil
+}
+
+func (s *WordService) SubmitReviewGrade(
+	ctx context.Context,
+	req *pb.SubmitReviewGradeRequest,
+) (*pb.SubmitReviewGradeResponse, error) {
+	userID, err := s.userID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetFavoriteWordMeaningId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "favorite_word_meaning_id is required")
+	}
+	grade := int(req.GetGrade())
+	if grade < srs.MinGrade || grade > srs.MaxGrade {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"grade must be between %d and %d", srs.MinGrade, srs.MaxGrade)
+	}
+
+	review, err := s.store.FindReview(ctx, userID, req.GetFavoriteWordMeaningId())
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	review.Card = review.Card.Review(grade, s.now())
+	if err := s.store.SaveReview(ctx, review); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	pbReview, err := s.reviewToPB(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitReviewGradeResponse{
+		Review: pbReview,
+	}, nil
+}
+
+func (s *WordService) reviewToPB(ctx context.Context, review *store.Review) (*pb.Review, error) {
+	wordMeaning, err := s.store.FindWordMeaning(ctx, review.WordMeaningID)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	wordMeaning.FavoriteWordMeaningId = review.FavoriteID
+
+	pbReview := &pb.Review{
+		FavoriteWordMeaningId: review.FavoriteID,
+		WordMeaning:           wordMeaning,
+		EaseFactor:            review.Card.EaseFactor,
+		IntervalDays:          int32(review.Card.IntervalDays),
+		Repetitions:           int32(review.Card.Repetitions),
+		DueAt:                 timestamppb.New(review.Card.DueAt),
+	}
+	if !review.Card.LastReviewedAt.IsZero() {
+		pbReview.LastReviewedAt = timestamppb.New(review.Card.LastReviewedAt)
+	}
+	return pbReview, nil
+}
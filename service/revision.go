@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kakurineuin/learn-english-word/auth"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+var revisionActions = map[store.ChangeAction]pb.RevisionAction{
+	store.ChangeCreate: pb.RevisionAction_REVISION_ACTION_CREATE,
+	store.ChangeUpdate: pb.RevisionAction_REVISION_ACTION_UPDATE,
+	store.ChangeDelete: pb.RevisionAction_REVISION_ACTION_DELETE,
+}
+
+func (s *WordService) ListWordMeaningRevisions(
+	ctx context.Context,
+	req *pb.ListWordMeaningRevisionsRequest,
+) (*pb.ListWordMeaningRevisionsResponse, error) {
+	if _, err := s.editorID(ctx, req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if req.GetWordMeaningId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning_id is required")
+	}
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	changes, next, total, err := s.changes.FindWordMeaningChanges(ctx, store.WordMeaningChangeQuery{
+		WordMeaningID: req.GetWordMeaningId(),
+		Page: store.Page{
+			Size:  int(req.GetPageSize()),
+			Token: req.GetPageToken(),
+		},
+	})
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	revisions := make([]*pb.WordMeaningRevision, 0, len(changes))
+	for _, change := range changes {
+		revisions = append(revisions, revisionToPB(change))
+	}
+
+	return &pb.ListWordMeaningRevisionsResponse{
+		Revisions:     revisions,
+		NextPageToken: next,
+		Total:         total,
+	}, nil
+}
+
+// RevertWordMeaning undoes a revision by putting back the entry as it was
+// before it: a created entry is deleted, a deleted one is created again and
+// an updated one gets its former fields back. Editors may revert their own
+// revisions; those of others need an admin. A revision that another editor
+// has revised on top of is not reverted, since that would drop their edit,
+// unless the caller is an admin too. The revert is itself a revision, so it
+// can be undone the same way.
+func (s *WordService) RevertWordMeaning(
+	ctx context.Context,
+	req *pb.RevertWordMeaningRequest,
+) (*pb.RevertWordMeaningResponse, error) {
+	userID, err := s.editorID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	editor, err := s.editor()
+	if err != nil {
+		return nil, err
+	}
+	if req.GetRevisionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "revision_id is required")
+	}
+	reverted, err := s.changes.FindWordMeaningChange(ctx, req.GetRevisionId())
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	identity, _ := auth.FromContext(ctx)
+	revertsOthers := identity.Role.Can(auth.PermissionRevertOthers)
+	if reverted.UserID != userID && !revertsOthers {
+		return nil, status.Error(codes.PermissionDenied, "only an admin may revert another editor's revision")
+	}
+	unlock := s.edits.lock(reverted.WordMeaningID)
+	defer unlock()
+	if !revertsOthers {
+		later, err := s.laterEditor(ctx, reverted, userID)
+		if err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		if later != "" {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"the word meaning has been revised by %s since; only an admin may revert it", later)
+		}
+	}
+
+	current, err := editor.FindWordMeaningByID(ctx, reverted.WordMeaningID)
+	if errors.Is(err, store.ErrNotFound) {
+		current, err = nil, nil
+	}
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+
+	change := &store.WordMeaningChange{
+		UserID:           userID,
+		Before:           current,
+		RevertedChangeID: reverted.ID,
+	}
+	switch {
+	case reverted.Before != nil:
+		restored := proto.Clone(reverted.Before).(*pb.WordMeaning)
+		restored.FavoriteWordMeaningId = ""
+		if err := editor.PutWordMeaning(ctx, restored); err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		change.Action = store.ChangeUpdate
+		if current == nil {
+			change.Action = store.ChangeCreate
+		}
+		change.After = restored
+	case current != nil:
+		if err := editor.DeleteWordMeaning(ctx, current.GetId()); err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+		change.Action = store.ChangeDelete
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "the word meaning is deleted already")
+	}
+
+	created, err := s.recordEdit(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevertWordMeaningResponse{
+		Revision: revisionToPB(created),
+	}, nil
+}
+
+// laterEditor returns the user, other than userID, who made the newest
+// revision of the entry after reverted, or "" when there is none.
+func (s *WordService) laterEditor(
+	ctx context.Context,
+	reverted *store.WordMeaningChange,
+	userID string,
+) (string, error) {
+	query := store.WordMeaningChangeQuery{
+		WordMeaningID: reverted.WordMeaningID,
+		Page:          store.Page{Size: store.MaxPageSize},
+	}
+	for {
+		changes, next, _, err := s.changes.FindWordMeaningChanges(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		// Changes come newest first, so the reverted one ends the search.
+		for _, change := range changes {
+			if change.ID == reverted.ID {
+				return "", nil
+			}
+			if change.UserID != userID {
+				return change.UserID, nil
+			}
+		}
+		if next == "" {
+			return "", nil
+		}
+		query.Page.Token = next
+	}
+}
+
+func revisionToPB(change *store.WordMeaningChange) *pb.WordMeaningRevision {
+	diff := make([]*pb.FieldDiff, 0, len(change.Diff))
+	for _, d := range change.Diff {
+		diff = append(diff, &pb.FieldDiff{
+			Field:  d.Field,
+			Before: d.Before,
+			After:  d.After,
+		})
+	}
+
+	return &pb.WordMeaningRevision{
+		Id:                 change.ID,
+		WordMeaningId:      change.WordMeaningID,
+		UserId:             change.UserID,
+		Action:             revisionActions[change.Action],
+		WordMeaning:        change.After,
+		Diff:               diff,
+		RevertedRevisionId: change.RevertedChangeID,
+		CreatedAt:          timestamppb.New(change.CreatedAt),
+	}
+}
+
+// diffWordMeanings returns the fields that differ between two versions of an
+// entry, either of which may be nil. favorite_word_meaning_id belongs to the
+// saved copy rather than to the entry, so it is left out.
+func diffWordMeanings(before, after *pb.WordMeaning) ([]store.FieldDiff, error) {
+	var diff []store.FieldDiff
+	fields := (*pb.WordMeaning)(nil).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Name() == "favorite_word_meaning_id" {
+			continue
+		}
+		beforeValue, err := fieldJSON(before, field)
+		if err != nil {
+			return nil, err
+		}
+		afterValue, err := fieldJSON(after, field)
+		if err != nil {
+			return nil, err
+		}
+		if beforeValue != afterValue {
+			diff = append(diff, store.FieldDiff{
+				Field:  string(field.Name()),
+				Before: beforeValue,
+				After:  afterValue,
+			})
+		}
+	}
+	return diff, nil
+}
+
+// fieldJSON returns the compact protojson form of a field of wordMeaning, or
+// "" when the field is unset.
+func fieldJSON(wordMeaning *pb.WordMeaning, field protoreflect.FieldDescriptor) (string, error) {
+	if wordMeaning == nil {
+		return "", nil
+	}
+	m := wordMeaning.ProtoReflect()
+	if !m.Has(field) {
+		return "", nil
+	}
+
+	// protojson marshals messages only, so the field is marshalled alone in
+	// one and taken out of the result.
+	only := m.New()
+	only.Set(field, m.Get(field))
+	data, err := protojson.Marshal(only.Interface())
+	if err != nil {
+		return "", err
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return "", err
+	}
+	value, err := json.Marshal(object[field.JSONName()])
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
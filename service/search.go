@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+func (s *WordService) SearchByDefinition(
+	ctx context.Context,
+	req *pb.SearchByDefinitionRequest,
+) (*pb.SearchByDefinitionResponse, error) {
+	if s.search == nil {
+		return nil, status.Error(codes.FailedPrecondition, "definition search is not configured")
+	}
+	if normalizeWord(req.GetQuery()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	userID, err := s.optionalUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	case limit == 0:
+		limit = defaultSearchLimit
+	case limit > maxSearchLimit:
+		limit = maxSearchLimit
+	}
+
+	results := s.search.Search(req.GetQuery(), limit)
+	wordMeanings := make([]*pb.WordMeaning, 0, len(results))
+	for _, result := range results {
+		wordMeanings = append(wordMeanings, result.WordMeaning)
+	}
+	if err := s.store.SaveWordMeanings(ctx, wordMeanings); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	if userID != "" {
+		if err := s.fillFavoriteIDs(ctx, userID, wordMeanings); err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+	}
+
+	matches := make([]*pb.DefinitionMatch, 0, len(results))
+	for _, result := range results {
+		highlights := make([]*pb.Highlight, 0, len(result.Highlights))
+		for _, highlight := range result.Highlights {
+			spans := make([]*pb.TextSpan, 0, len(highlight.Spans))
+			for _, span := range highlight.Spans {
+				spans = append(spans, &pb.TextSpan{
+					Start: int32(span.Start),
+					End:   int32(span.End),
+				})
+			}
+			highlights = append(highlights, &pb.Highlight{
+				Field: highlight.Field,
+				Text:  highlight.Text,
+				Spans: spans,
+			})
+		}
+		matches = append(matches, &pb.DefinitionMatch{
+			WordMeaning: result.WordMeaning,
+			Score:       result.Score,
+			Highlights:  highlights,
+		})
+	}
+
+	return &pb.SearchByDefinitionResponse{
+		Matches: matches,
+	}, nil
+}
@@ -0,0 +1,274 @@
+// Package service implements pb.WordServiceServer on top of a pluggable
+// dictionary backend.
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/autocomplete"
+	"github.com/kakurineuin/learn-english-word/cache"
+	"github.com/kakurineuin/learn-english-word/lemma"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/search"
+	"github.com/kakurineuin/learn-english-word/store"
+	"github.com/kakurineuin/learn-english-word/suggest"
+)
+
+// maxSuggestions caps the spelling suggestions of a word not found.
+const maxSuggestions = 5
+
+// WordService is the reference implementation of pb.WordServiceServer.
+type WordService struct {
+	pb.UnimplementedWordServiceServer
+
+	source  DictionarySource
+	store   Store
+	changes WordMeaningChangeStore
+	logger  *slog.Logger
+	now     func() time.Time
+	edits   entryLocks
+
+	suggestions  *suggest.Index
+	autocomplete *autocomplete.Index
+	search       *search.Index
+	cache        *cache.Cache
+
+	lookupConcurrency int
+}
+
+// Option configures a WordService.
+type Option func(*WordService)
+
+// WithLogger sets the logger used to report backend failures.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *WordService) {
+		s.logger = logger
+	}
+}
+
+// WithSuggestions makes FindWordByDictionary suggest headwords from index
+// when a word is not found.
+func WithSuggestions(index *suggest.Index) Option {
+	return func(s *WordService) {
+		s.suggestions = index
+	}
+}
+
+// WithAutocomplete serves AutocompleteWords from index.
+func WithAutocomplete(index *autocomplete.Index) Option {
+	return func(s *WordService) {
+		s.autocomplete = index
+	}
+}
+
+// WithSearch serves SearchByDefinition from index.
+func WithSearch(index *search.Index) Option {
+	return func(s *WordService) {
+		s.search = index
+	}
+}
+
+// WithCache answers repeated lookups of a word from c instead of the
+// dictionary source.
+func WithCache(c *cache.Cache) Option {
+	return func(s *WordService) {
+		s.cache = c
+	}
+}
+
+// WithLookupConcurrency bounds the concurrent dictionary lookups of one
+// batch request.
+func WithLookupConcurrency(n int) Option {
+	return func(s *WordService) {
+		s.lookupConcurrency = max(n, 1)
+	}
+}
+
+// New returns a WordService that resolves words through source.
+func New(source DictionarySource, opts ...Option) *WordService {
+	s := &WordService{
+		source: source,
+		store:  store.NewMemory(),
+		logger: slog.Default(),
+		now:    time.Now,
+
+		lookupConcurrency: defaultLookupConcurrency,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.changes == nil {
+		s.changes = s.store
+	}
+	return s
+}
+
+func (s *WordService) FindWordByDictionary(
+	ctx context.Context,
+	req *pb.WordRequest,
+) (*pb.WordResponse, error) {
+	word := normalizeWord(req.GetWord())
+	if word == "" {
+		return nil, status.Error(codes.InvalidArgument, "word is required")
+	}
+	if req.GetMaxMeanings() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max_meanings must not be negative")
+	}
+	if _, ok := pb.WordMeaningOrder_name[int32(req.GetOrderBy())]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported order_by %v", req.GetOrderBy())
+	}
+
+	userID, err := s.optionalUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.findWord(ctx, word, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp.WordMeanings = selectWordMeanings(resp.GetWordMeanings(), req)
+	return resp, nil
+}
+
+// selectWordMeanings applies the filters, order and limit of req.
+func selectWordMeanings(wordMeanings []*pb.WordMeaning, req *pb.WordRequest) []*pb.WordMeaning {
+	partOfSpeech := strings.ToLower(strings.TrimSpace(req.GetPartOfSpeech()))
+	gram := strings.ToLower(strings.TrimSpace(req.GetGram()))
+
+	selected := wordMeanings[:0]
+	for _, wordMeaning := range wordMeanings {
+		if partOfSpeech != "" &&
+			strings.ToLower(strings.TrimSpace(wordMeaning.GetPartOfSpeech())) != partOfSpeech {
+			continue
+		}
+		if gram != "" && !strings.Contains(strings.ToLower(wordMeaning.GetGram()), gram) {
+			continue
+		}
+		selected = append(selected, wordMeaning)
+	}
+
+	if req.GetOrderBy() == pb.WordMeaningOrder_WORD_MEANING_ORDER_FREQUENCY {
+		sort.SliceStable(selected, func(i, j int) bool {
+			fi, fj := selected[i].GetFrequency(), selected[j].GetFrequency()
+			if fi != fj {
+				return fi > fj
+			}
+			return selected[i].GetOrderByNo() < selected[j].GetOrderByNo()
+		})
+	} else {
+		sortWordMeanings(selected)
+	}
+
+	if limit := int(req.GetMaxMeanings()); limit > 0 && len(selected) > limit {
+		selected = selected[:limit]
+	}
+	return selected
+}
+
+// findWord answers a lookup of a normalized word on behalf of userID, who
+// may be empty.
+func (s *WordService) findWord(
+	ctx context.Context,
+	word string,
+	userID string,
+) (*pb.WordResponse, error) {
+	wordMeanings, err := s.cachedLookup(ctx, word)
+	if err != nil {
+		return nil, s.sourceError(ctx, word, err)
+	}
+	if err := s.store.SaveWordMeanings(ctx, wordMeanings); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	if userID != "" {
+		if err := s.fillFavoriteIDs(ctx, userID, wordMeanings); err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+	}
+
+	resp := &pb.WordResponse{
+		WordMeanings: wordMeanings,
+	}
+	if len(wordMeanings) == 0 && s.suggestions != nil {
+		resp.Suggestions = s.suggestions.Suggest(word, maxSuggestions)
+	}
+	return resp, nil
+}
+
+// cachedLookup is lookup through the cache, if there is one.
+func (s *WordService) cachedLookup(ctx context.Context, word string) ([]*pb.WordMeaning, error) {
+	if s.cache == nil {
+		return s.lookup(ctx, word)
+	}
+	return s.cache.Get(ctx, word, func(ctx context.Context) ([]*pb.WordMeaning, error) {
+		return s.lookup(ctx, word)
+	})
+}
+
+// lookup resolves word through the dictionary source. A word with no entry
+// of its own is looked up as the headwords it may be an inflected form of,
+// so "mice" finds "mouse".
+func (s *WordService) lookup(ctx context.Context, word string) ([]*pb.WordMeaning, error) {
+	wordMeanings, err := s.source.FindWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	for _, candidate := range lemma.Lemmas(word) {
+		if len(wordMeanings) > 0 {
+			break
+		}
+		wordMeanings, err = s.source.FindWord(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, wordMeaning := range wordMeanings {
+		FillQueryByWords(wordMeaning, word)
+	}
+	return wordMeanings, nil
+}
+
+// FillQueryByWords adds the inflected forms of a word meaning's headword,
+// and the query that found it, to its comma separated QueryByWords.
+func FillQueryByWords(wordMeaning *pb.WordMeaning, query string) {
+	var words []string
+	for _, word := range strings.Split(wordMeaning.GetQueryByWords(), ",") {
+		if word = strings.TrimSpace(word); word != "" && !slices.Contains(words, word) {
+			words = append(words, word)
+		}
+	}
+	forms := lemma.Forms(wordMeaning.GetWord(), wordMeaning.GetPartOfSpeech())
+	for _, word := range append(forms, query) {
+		if !slices.Contains(words, word) {
+			words = append(words, word)
+		}
+	}
+	wordMeaning.QueryByWords = strings.Join(words, ",")
+}
+
+// sourceError converts a backend error into a gRPC status, keeping context
+// errors recognisable to the client.
+func (s *WordService) sourceError(ctx context.Context, word string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	s.logger.ErrorContext(ctx, "dictionary lookup failed", "word", word, "error", err)
+	return status.Errorf(codes.Unavailable, "dictionary lookup for %q failed", word)
+}
+
+// normalizeWord folds a query into the form used as a dictionary key.
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.TrimSpace(word))
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+// DictionarySource is the backend contract used to resolve a word.
+//
+// FindWord receives a normalized (trimmed, lower-case) word and returns its
+// meanings ordered by OrderByNo, each with a non-empty id that stays the same
+// across calls. An unknown word is not an error: it yields an empty slice.
+// The returned messages are owned by the caller. Implementations must be
+// safe for concurrent use.
+//
+// MemorySource and LoadFileSource are the backends shipped with the service;
+// PersistentSource keeps what another backend resolves.
+type DictionarySource interface {
+	FindWord(ctx context.Context, word string) ([]*pb.WordMeaning, error)
+}
+
+// DictionaryWalker is implemented by sources that can enumerate their
+// entries, which the spelling suggestion, autocomplete and search indexes are
+// built from.
+type DictionaryWalker interface {
+	// WalkWordMeanings calls fn for every entry, stopping at the first error
+	// fn returns.
+	WalkWordMeanings(ctx context.Context, fn func(*pb.WordMeaning) error) error
+}
+
+// DictionaryEditor is implemented by sources whose entries can be changed.
+// Changes are seen by FindWord and WalkWordMeanings as soon as the call
+// making them returns.
+type DictionaryEditor interface {
+	// FindWordMeaningByID returns the entry with id, or store.ErrNotFound.
+	FindWordMeaningByID(ctx context.Context, id string) (*pb.WordMeaning, error)
+	// PutWordMeaning adds wordMeaning, which has a normalized headword and an
+	// id, replacing the entry with the same id wherever it is.
+	PutWordMeaning(ctx context.Context, wordMeaning *pb.WordMeaning) error
+	// DeleteWordMeaning removes the entry with id, or returns
+	// store.ErrNotFound.
+	DeleteWordMeaning(ctx context.Context, id string) error
+}
+
+// MemorySource is a DictionarySource that serves entries held in memory.
+type MemorySource struct {
+	mu      sync.RWMutex
+	entries map[string][]*pb.WordMeaning
+}
+
+// ErrDuplicateID is returned when entries added to a MemorySource share an
+// id.
+var ErrDuplicateID = errors.New("duplicate word meaning id")
+
+// NewMemorySource returns an empty MemorySource.
+func NewMemorySource() *MemorySource {
+	return &MemorySource{
+		entries: make(map[string][]*pb.WordMeaning),
+	}
+}
+
+// Add stores wordMeanings under their headword. Meanings without an order
+// are numbered after the other meanings of their headword, in the order
+// given, and meanings without an id are given a stable one derived from the
+// headword, part of speech and order. Nothing is added if an id is taken,
+// and ErrDuplicateID is returned.
+func (m *MemorySource) Add(wordMeanings ...*pb.WordMeaning) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make(map[string]bool)
+	lastOrder := make(map[string]int32)
+	for word, existing := range m.entries {
+		for _, wordMeaning := range existing {
+			ids[wordMeaning.GetId()] = true
+			lastOrder[word] = max(lastOrder[word], wordMeaning.GetOrderByNo())
+		}
+	}
+	for _, wordMeaning := range wordMeanings {
+		if word := normalizeWord(wordMeaning.GetWord()); word != "" {
+			lastOrder[word] = max(lastOrder[word], wordMeaning.GetOrderByNo())
+		}
+	}
+
+	added := make(map[string][]*pb.WordMeaning)
+	for _, wordMeaning := range wordMeanings {
+		wordMeaning = proto.Clone(wordMeaning).(*pb.WordMeaning)
+		word := normalizeWord(wordMeaning.GetWord())
+		if word == "" {
+			continue
+		}
+		if wordMeaning.GetOrderByNo() == 0 {
+			lastOrder[word]++
+			wordMeaning.OrderByNo = lastOrder[word]
+		}
+		if wordMeaning.GetId() == "" {
+			wordMeaning.Id = store.WordMeaningID(
+				word,
+				wordMeaning.GetPartOfSpeech(),
+				wordMeaning.GetOrderByNo(),
+			)
+		}
+		if ids[wordMeaning.GetId()] {
+			return fmt.Errorf("%w: %q (%s)", ErrDuplicateID, wordMeaning.GetId(), word)
+		}
+		ids[wordMeaning.GetId()] = true
+		added[word] = append(added[word], wordMeaning)
+	}
+
+	for word, wordMeanings := range added {
+		m.entries[word] = append(m.entries[word], wordMeanings...)
+		sortWordMeanings(m.entries[word])
+	}
+	return nil
+}
+
+func (m *MemorySource) FindWord(_ context.Context, word string) ([]*pb.WordMeaning, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return cloneWordMeanings(m.entries[word]), nil
+}
+
+func (m *MemorySource) WalkWordMeanings(
+	ctx context.Context,
+	fn func(*pb.WordMeaning) error,
+) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, wordMeanings := range m.entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for _, wordMeaning := range wordMeanings {
+			if err := fn(proto.Clone(wordMeaning).(*pb.WordMeaning)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// FindWordMeaningByID looks at every entry; edits are expected to be rare.
+func (m *MemorySource) FindWordMeaningByID(_ context.Context, id string) (*pb.WordMeaning, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	word, i := m.locate(id)
+	if i < 0 {
+		return nil, store.ErrNotFound
+	}
+	return proto.Clone(m.entries[word][i]).(*pb.WordMeaning), nil
+}
+
+func (m *MemorySource) PutWordMeaning(_ context.Context, wordMeaning *pb.WordMeaning) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if word, i := m.locate(wordMeaning.GetId()); i >= 0 {
+		m.entries[word] = slices.Delete(m.entries[word], i, i+1)
+	}
+	word := wordMeaning.GetWord()
+	m.entries[word] = append(m.entries[word], proto.Clone(wordMeaning).(*pb.WordMeaning))
+	sortWordMeanings(m.entries[word])
+	return nil
+}
+
+func (m *MemorySource) DeleteWordMeaning(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	word, i := m.locate(id)
+	if i < 0 {
+		return store.ErrNotFound
+	}
+	m.entries[word] = slices.Delete(m.entries[word], i, i+1)
+	return nil
+}
+
+// locate returns the headword and index of the entry with id, or -1.
+func (m *MemorySource) locate(id string) (string, int) {
+	for word, wordMeanings := range m.entries {
+		for i, wordMeaning := range wordMeanings {
+			if wordMeaning.GetId() == id {
+				return word, i
+			}
+		}
+	}
+	return "", -1
+}
+
+func sortWordMeanings(wordMeanings []*pb.WordMeaning) {
+	sort.SliceStable(wordMeanings, func(i, j int) bool {
+		return wordMeanings[i].GetOrderByNo() < wordMeanings[j].GetOrderByNo()
+	})
+}
+
+func cloneWordMeanings(wordMeanings []*pb.WordMeaning) []*pb.WordMeaning {
+	clones := make([]*pb.WordMeaning, 0, len(wordMeanings))
+	for _, wordMeaning := range wordMeanings {
+		clones = append(clones, proto.Clone(wordMeaning).(*pb.WordMeaning))
+	}
+	return clones
+}
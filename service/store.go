@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+// WordMeaningStore remembers the word meanings served by lookups so that
+// user data can refer to them by id.
+type WordMeaningStore interface {
+	SaveWordMeanings(ctx context.Context, wordMeanings []*pb.WordMeaning) error
+	FindWordMeaning(ctx context.Context, id string) (*pb.WordMeaning, error)
+	SampleWordMeanings(ctx context.Context, n int) ([]*pb.WordMeaning, error)
+}
+
+// FavoriteStore persists the word meanings users have saved.
+type FavoriteStore interface {
+	CreateFavorite(ctx context.Context, userID, wordMeaningID string) (*store.Favorite, error)
+	DeleteFavorite(ctx context.Context, userID, id string) error
+	FindFavorites(ctx context.Context, query store.FavoriteQuery) ([]*store.Favorite, string, int64, error)
+	FavoriteIDs(ctx context.Context, userID string, wordMeaningIDs []string) (map[string]string, error)
+}
+
+// ExamStore persists exams.
+type ExamStore interface {
+	CreateExam(ctx context.Context, exam *store.Exam) (*store.Exam, error)
+	UpdateExam(ctx context.Context, exam *store.Exam) (*store.Exam, error)
+	DeleteExam(ctx context.Context, userID, id string) error
+	FindExam(ctx context.Context, userID, id string) (*store.Exam, error)
+	FindExams(ctx context.Context, query store.ExamQuery) ([]*store.Exam, string, int64, error)
+}
+
+// QuestionStore persists the questions of exams.
+type QuestionStore interface {
+	CreateQuestion(ctx context.Context, question *store.Question) (*store.Question, error)
+	UpdateQuestion(ctx context.Context, question *store.Question) (*store.Question, error)
+	DeleteQuestion(ctx context.Context, userID, id string) error
+	FindQuestions(ctx context.Context, query store.QuestionQuery) ([]*store.Question, string, int64, error)
+}
+
+// ExamRecordStore persists the attempts of users at exams.
+type ExamRecordStore interface {
+	ExamQuestions(ctx context.Context, userID, examID string) ([]*store.Question, error)
+	CreateExamRecord(ctx context.Context, record *store.ExamRecord) (*store.ExamRecord, error)
+	FindExamRecords(ctx context.Context, query store.ExamRecordQuery) ([]*store.ExamRecord, string, int64, error)
+	ExamScores(ctx context.Context, userID, examID string) ([]*store.ExamRecord, error)
+}
+
+// ReviewStore persists the review schedules of favorites.
+type ReviewStore interface {
+	FindReview(ctx context.Context, userID, favoriteID string) (*store.Review, error)
+	SaveReview(ctx context.Context, review *store.Review) error
+	FindDueReviews(ctx context.Context, userID string, now time.Time, limit int) ([]*store.Review, int64, error)
+}
+
+// WordMeaningChangeStore keeps the audit trail of dictionary edits, which is
+// also the revision history of the entries.
+type WordMeaningChangeStore interface {
+	CreateWordMeaningChange(ctx context.Context, change *store.WordMeaningChange) (*store.WordMeaningChange, error)
+	FindWordMeaningChange(ctx context.Context, id string) (*store.WordMeaningChange, error)
+	FindWordMeaningChanges(ctx context.Context, query store.WordMeaningChangeQuery) ([]*store.WordMeaningChange, string, int64, error)
+}
+
+// Store is everything WordService persists. store.Memory implements it.
+type Store interface {
+	WordMeaningStore
+	FavoriteStore
+	ExamStore
+	QuestionStore
+	ExamRecordStore
+	ReviewStore
+	WordMeaningChangeStore
+}
+
+// WithStore replaces the default in-memory store.
+func WithStore(st Store) Option {
+	return func(s *WordService) {
+		s.store = st
+	}
+}
+
+// WithChangeStore keeps the revision history of dictionary entries in
+// changes instead of the Store, typically next to the entries themselves so
+// it lasts as long as they do.
+func WithChangeStore(changes WordMeaningChangeStore) Option {
+	return func(s *WordService) {
+		s.changes = changes
+	}
+}
+
+// storeError converts a store error into a gRPC status.
+func (s *WordService) storeError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, store.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return status.FromContextError(ctxErr).Err()
+	}
+	s.logger.ErrorContext(ctx, "store operation failed", "error", err)
+	return status.Error(codes.Internal, "store operation failed")
+}
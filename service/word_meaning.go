@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/lemma"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/store"
+)
+
+func (s *WordService) CreateWordMeaning(
+	ctx context.Context,
+	req *pb.CreateWordMeaningRequest,
+) (*pb.CreateWordMeaningResponse, error) {
+	userID, err := s.editorID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	editor, err := s.editor()
+	if err != nil {
+		return nil, err
+	}
+	wordMeaning, err := newWordMeaning(req.GetWordMeaning())
+	if err != nil {
+		return nil, err
+	}
+	wordMeaning.Id = store.NewID()
+	if wordMeaning.GetOrderByNo() == 0 {
+		existing, err := s.source.FindWord(ctx, wordMeaning.GetWord())
+		if err != nil {
+			return nil, s.sourceError(ctx, wordMeaning.GetWord(), err)
+		}
+		for _, e := range existing {
+			wordMeaning.OrderByNo = max(wordMeaning.GetOrderByNo(), e.GetOrderByNo())
+		}
+		wordMeaning.OrderByNo++
+	}
+
+	if err := editor.PutWordMeaning(ctx, wordMeaning); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	_, err = s.recordEdit(ctx, &store.WordMeaningChange{
+		UserID: userID,
+		Action: store.ChangeCreate,
+		After:  wordMeaning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWordMeaningResponse{
+		WordMeaning: wordMeaning,
+	}, nil
+}
+
+func (s *WordService) UpdateWordMeaning(
+	ctx context.Context,
+	req *pb.UpdateWordMeaningRequest,
+) (*pb.UpdateWordMeaningResponse, error) {
+	userID, err := s.editorID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	editor, err := s.editor()
+	if err != nil {
+		return nil, err
+	}
+	id := req.GetWordMeaning().GetId()
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning.id is required")
+	}
+	wordMeaning, err := newWordMeaning(req.GetWordMeaning())
+	if err != nil {
+		return nil, err
+	}
+	unlock := s.edits.lock(id)
+	defer unlock()
+	before, err := s.findEditable(ctx, editor, id)
+	if err != nil {
+		return nil, err
+	}
+	if wordMeaning.GetOrderByNo() == 0 {
+		wordMeaning.OrderByNo = before.GetOrderByNo()
+	}
+
+	if err := editor.PutWordMeaning(ctx, wordMeaning); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	_, err = s.recordEdit(ctx, &store.WordMeaningChange{
+		UserID: userID,
+		Action: store.ChangeUpdate,
+		Before: before,
+		After:  wordMeaning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateWordMeaningResponse{
+		WordMeaning: wordMeaning,
+	}, nil
+}
+
+func (s *WordService) DeleteWordMeaning(
+	ctx context.Context,
+	req *pb.DeleteWordMeaningRequest,
+) (*pb.DeleteWordMeaningResponse, error) {
+	userID, err := s.editorID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	editor, err := s.editor()
+	if err != nil {
+		return nil, err
+	}
+	if req.GetWordMeaningId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning_id is required")
+	}
+	unlock := s.edits.lock(req.GetWordMeaningId())
+	defer unlock()
+	before, err := s.findEditable(ctx, editor, req.GetWordMeaningId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := editor.DeleteWordMeaning(ctx, before.GetId()); err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	_, err = s.recordEdit(ctx, &store.WordMeaningChange{
+		UserID: userID,
+		Action: store.ChangeDelete,
+		Before: before,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteWordMeaningResponse{}, nil
+}
+
+// editor returns the dictionary source as a DictionaryEditor.
+func (s *WordService) editor() (DictionaryEditor, error) {
+	editor, ok := s.source.(DictionaryEditor)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "the dictionary cannot be edited")
+	}
+	return editor, nil
+}
+
+// newWordMeaning validates an entry sent by an editor and returns the copy
+// of it to store.
+func newWordMeaning(in *pb.WordMeaning) (*pb.WordMeaning, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning is required")
+	}
+	wordMeaning := proto.Clone(in).(*pb.WordMeaning)
+	wordMeaning.Word = normalizeWord(wordMeaning.GetWord())
+	if wordMeaning.GetWord() == "" {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning.word is required")
+	}
+	wordMeaning.Definition = strings.TrimSpace(wordMeaning.GetDefinition())
+	if wordMeaning.GetDefinition() == "" {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning.definition is required")
+	}
+	if wordMeaning.GetOrderByNo() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "word_meaning.order_by_no must not be negative")
+	}
+	wordMeaning.FavoriteWordMeaningId = ""
+	FillQueryByWords(wordMeaning, wordMeaning.GetWord())
+	return wordMeaning, nil
+}
+
+// findEditable returns the entry id as the editor holds it. An entry that
+// was served to users without going through the source yet, such as a
+// search result, is fetched through the source first.
+func (s *WordService) findEditable(
+	ctx context.Context,
+	editor DictionaryEditor,
+	id string,
+) (*pb.WordMeaning, error) {
+	wordMeaning, err := editor.FindWordMeaningByID(ctx, id)
+	if errors.Is(err, store.ErrNotFound) {
+		served, serr := s.store.FindWordMeaning(ctx, id)
+		if serr != nil {
+			return nil, s.storeError(ctx, serr)
+		}
+		if _, serr := s.source.FindWord(ctx, served.GetWord()); serr != nil {
+			return nil, s.sourceError(ctx, served.GetWord(), serr)
+		}
+		wordMeaning, err = editor.FindWordMeaningByID(ctx, id)
+	}
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	return wordMeaning, nil
+}
+
+// recordEdit adds an edit, made to the dictionary already, to the revision
+// history and brings everything derived from the dictionary up to date with
+// it: the saved copy users' data refers to, cached lookups and the indexes.
+func (s *WordService) recordEdit(
+	ctx context.Context,
+	change *store.WordMeaningChange,
+) (*store.WordMeaningChange, error) {
+	before, after := change.Before, change.After
+	var id string
+	var words []string
+	for _, wordMeaning := range []*pb.WordMeaning{before, after} {
+		if wordMeaning != nil {
+			id = wordMeaning.GetId()
+			if !slices.Contains(words, wordMeaning.GetWord()) {
+				words = append(words, wordMeaning.GetWord())
+			}
+		}
+	}
+
+	s.invalidate(before, after)
+	s.reindex(ctx, before, after)
+	if after != nil {
+		if err := s.store.SaveWordMeanings(ctx, []*pb.WordMeaning{after}); err != nil {
+			return nil, s.storeError(ctx, err)
+		}
+	}
+
+	change.WordMeaningID = id
+	diff, err := diffWordMeanings(before, after)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "diff of word meaning %q: %v", id, err)
+	}
+	change.Diff = diff
+	created, err := s.changes.CreateWordMeaningChange(ctx, change)
+	if err != nil {
+		return nil, s.storeError(ctx, err)
+	}
+	s.logger.InfoContext(ctx, "dictionary entry changed",
+		"action", created.Action,
+		"word_meaning_id", id,
+		"words", words,
+		"user_id", created.UserID,
+		"revision_id", created.ID,
+	)
+	return created, nil
+}
+
+// invalidate drops the cached lookups that may have served the given
+// versions of an entry: those of its headword and of every form of it.
+func (s *WordService) invalidate(wordMeanings ...*pb.WordMeaning) {
+	if s.cache == nil {
+		return
+	}
+	for _, wordMeaning := range wordMeanings {
+		if wordMeaning == nil {
+			continue
+		}
+		s.cache.Invalidate(wordMeaning.GetWord())
+		for _, form := range lemma.Forms(wordMeaning.GetWord(), wordMeaning.GetPartOfSpeech()) {
+			s.cache.Invalidate(form)
+		}
+		for _, form := range strings.Split(wordMeaning.GetQueryByWords(), ",") {
+			if form = strings.TrimSpace(form); form != "" {
+				s.cache.Invalidate(form)
+			}
+		}
+	}
+}
+
+// reindex updates the suggestion, autocomplete and search indexes for the
+// headwords of the given versions of an entry.
+func (s *WordService) reindex(ctx context.Context, before, after *pb.WordMeaning) {
+	if s.search != nil {
+		if before != nil {
+			s.search.Remove(before.GetId())
+		}
+		if after != nil {
+			s.search.Add(after)
+		}
+	}
+	if s.suggestions == nil && s.autocomplete == nil {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, wordMeaning := range []*pb.WordMeaning{before, after} {
+		if wordMeaning == nil || seen[wordMeaning.GetWord()] {
+			continue
+		}
+		word := wordMeaning.GetWord()
+		seen[word] = true
+
+		current, err := s.source.FindWord(ctx, word)
+		if err != nil {
+			s.logger.WarnContext(ctx, "reindex after edit failed", "word", word, "error", err)
+			continue
+		}
+		if s.suggestions != nil {
+			if len(current) == 0 {
+				s.suggestions.Remove(word)
+			} else {
+				s.suggestions.Add(word)
+			}
+		}
+		if s.autocomplete != nil {
+			s.autocomplete.Remove(word)
+			for _, wordMeaning := range current {
+				s.autocomplete.Add(word, wordMeaning.GetPartOfSpeech())
+			}
+		}
+	}
+}
+
+// entryLocks serializes the edits of each entry, so that an edit reads the
+// entry, writes it and records the revision before the next one reads it.
+// Otherwise two edits could record the same former version. The zero value
+// is ready to use.
+type entryLocks struct {
+	mu    sync.Mutex
+	locks map[string]*entryLock
+}
+
+type entryLock struct {
+	mu      sync.Mutex
+	holders int
+}
+
+// lock locks the entry id and returns the function unlocking it.
+func (l *entryLocks) lock(id string) (unlock func()) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*entryLock)
+	}
+	entry, ok := l.locks[id]
+	if !ok {
+		entry = &entryLock{}
+		l.locks[id] = entry
+	}
+	entry.holders++
+	l.mu.Unlock()
+
+	entry.mu.Lock()
+	return func() {
+		entry.mu.Unlock()
+		l.mu.Lock()
+		if entry.holders--; entry.holders == 0 {
+			delete(l.locks, id)
+		}
+		l.mu.Unlock()
+	}
+}
@@ -0,0 +1,68 @@
+// Package srs schedules reviews with the SM-2 spaced-repetition algorithm.
+package srs
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// InitialEaseFactor is the ease factor of a card never reviewed.
+	InitialEaseFactor = 2.5
+	// MinEaseFactor is the lowest ease factor SM-2 allows.
+	MinEaseFactor = 1.3
+
+	// MinGrade and MaxGrade bound the recall quality of a review.
+	MinGrade = 0
+	MaxGrade = 5
+	// PassingGrade is the lowest grade counted as a successful recall.
+	PassingGrade = 3
+)
+
+const day = 24 * time.Hour
+
+// Card is the review schedule of one item.
+type Card struct {
+	EaseFactor float64
+	// IntervalDays is the gap between the last review and DueAt.
+	IntervalDays int
+	// Repetitions counts consecutive successful reviews.
+	Repetitions    int
+	DueAt          time.Time
+	LastReviewedAt time.Time
+}
+
+// NewCard returns a card due at dueAt that has never been reviewed.
+func NewCard(dueAt time.Time) Card {
+	return Card{
+		EaseFactor: InitialEaseFactor,
+		DueAt:      dueAt,
+	}
+}
+
+// Review returns the card rescheduled after a review graded grade at now.
+// grade is clamped to [MinGrade, MaxGrade].
+func (c Card) Review(grade int, now time.Time) Card {
+	grade = min(max(grade, MinGrade), MaxGrade)
+
+	if grade < PassingGrade {
+		c.Repetitions = 0
+		c.IntervalDays = 1
+	} else {
+		switch c.Repetitions {
+		case 0:
+			c.IntervalDays = 1
+		case 1:
+			c.IntervalDays = 6
+		default:
+			c.IntervalDays = int(math.Round(float64(c.IntervalDays) * c.EaseFactor))
+		}
+		c.Repetitions++
+	}
+
+	q := float64(MaxGrade - grade)
+	c.EaseFactor = math.Max(MinEaseFactor, c.EaseFactor+0.1-q*(0.08+q*0.02))
+	c.LastReviewedAt = now
+	c.DueAt = now.Add(time.Duration(c.IntervalDays) * day)
+	return c
+}
@@ -0,0 +1,119 @@
+package srs
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestReview(t *testing.T) {
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		card           Card
+		grade          int
+		wantInterval   int
+		wantReps       int
+		wantEaseFactor float64
+	}{
+		{
+			name:           "first success",
+			card:           NewCard(now),
+			grade:          4,
+			wantInterval:   1,
+			wantReps:       1,
+			wantEaseFactor: 2.5,
+		},
+		{
+			name:           "second success",
+			card:           Card{EaseFactor: 2.5, IntervalDays: 1, Repetitions: 1},
+			grade:          5,
+			wantInterval:   6,
+			wantReps:       2,
+			wantEaseFactor: 2.6,
+		},
+		{
+			name:           "later success multiplies by the ease factor",
+			card:           Card{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2},
+			grade:          4,
+			wantInterval:   15,
+			wantReps:       3,
+			wantEaseFactor: 2.5,
+		},
+		{
+			name:           "interval is rounded",
+			card:           Card{EaseFactor: 1.7, IntervalDays: 15, Repetitions: 3},
+			grade:          3,
+			wantInterval:   26, // 25.5
+			wantReps:       4,
+			wantEaseFactor: 1.56,
+		},
+		{
+			name:           "failure starts over",
+			card:           Card{EaseFactor: 2.5, IntervalDays: 15, Repetitions: 3},
+			grade:          2,
+			wantInterval:   1,
+			wantReps:       0,
+			wantEaseFactor: 2.18,
+		},
+		{
+			name:           "ease factor floor",
+			card:           Card{EaseFactor: 1.4, IntervalDays: 6, Repetitions: 2},
+			grade:          0,
+			wantInterval:   1,
+			wantReps:       0,
+			wantEaseFactor: MinEaseFactor,
+		},
+		{
+			name:           "grade above the maximum",
+			card:           NewCard(now),
+			grade:          9,
+			wantInterval:   1,
+			wantReps:       1,
+			wantEaseFactor: 2.6,
+		},
+		{
+			name:           "grade below the minimum",
+			card:           Card{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2},
+			grade:          -3,
+			wantInterval:   1,
+			wantReps:       0,
+			wantEaseFactor: 1.7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.card.Review(tt.grade, now)
+			if got.IntervalDays != tt.wantInterval {
+				t.Errorf("IntervalDays = %d, want %d", got.IntervalDays, tt.wantInterval)
+			}
+			if got.Repetitions != tt.wantReps {
+				t.Errorf("Repetitions = %d, want %d", got.Repetitions, tt.wantReps)
+			}
+			if math.Abs(got.EaseFactor-tt.wantEaseFactor) > 1e-9 {
+				t.Errorf("EaseFactor = %v, want %v", got.EaseFactor, tt.wantEaseFactor)
+			}
+			if want := now.Add(time.Duration(tt.wantInterval) * 24 * time.Hour); !got.DueAt.Equal(want) {
+				t.Errorf("DueAt = %v, want %v", got.DueAt, want)
+			}
+			if !got.LastReviewedAt.Equal(now) {
+				t.Errorf("LastReviewedAt = %v, want %v", got.LastReviewedAt, now)
+			}
+		})
+	}
+}
+
+func TestReviewSequence(t *testing.T) {
+	// Successive good reviews of a new card: 1, 6, then intervals growing
+	// by the ease factor, which good grades leave unchanged.
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	card := NewCard(now)
+	for i, want := range []int{1, 6, 15, 38, 95} {
+		card = card.Review(4, card.DueAt)
+		if card.IntervalDays != want {
+			t.Fatalf("review %d: IntervalDays = %d, want %d", i+1, card.IntervalDays, want)
+		}
+	}
+}
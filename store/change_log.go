@@ -0,0 +1,168 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/kakurineuin/learn-english-word/dictdb"
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// ChangeLog keeps WordMeaningChanges in a dictionary file, next to the
+// entries they changed, so the history of an edited entry lasts as long as
+// the edit does. Listing reads every change in the file; edits are expected
+// to be rare.
+type ChangeLog struct {
+	db  *dictdb.DB
+	now func() time.Time
+}
+
+// NewChangeLog returns a ChangeLog writing to db.
+func NewChangeLog(db *dictdb.DB) *ChangeLog {
+	return &ChangeLog{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// changeRecord is the form of a WordMeaningChange in the file; entries are
+// in their protojson form, as in dictionary dumps.
+type changeRecord struct {
+	WordMeaningID    string          `json:"word_meaning_id"`
+	UserID           string          `json:"user_id"`
+	Action           ChangeAction    `json:"action"`
+	Before           json.RawMessage `json:"before,omitempty"`
+	After            json.RawMessage `json:"after,omitempty"`
+	Diff             []FieldDiff     `json:"diff,omitempty"`
+	RevertedChangeID string          `json:"reverted_change_id,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+}
+
+// CreateWordMeaningChange records change, assigning its id and, when unset,
+// its creation time.
+func (l *ChangeLog) CreateWordMeaningChange(
+	_ context.Context,
+	change *WordMeaningChange,
+) (*WordMeaningChange, error) {
+	created := change.clone()
+	created.ID = NewID()
+	if created.CreatedAt.IsZero() {
+		created.CreatedAt = l.now()
+	}
+
+	value, err := encodeChange(created)
+	if err != nil {
+		return nil, fmt.Errorf("encode change: %w", err)
+	}
+	if err := l.db.PutChange(created.ID, value); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// FindWordMeaningChange returns the change id.
+func (l *ChangeLog) FindWordMeaningChange(_ context.Context, id string) (*WordMeaningChange, error) {
+	value, ok, err := l.db.Change(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return decodeChange(id, value)
+}
+
+// FindWordMeaningChanges returns a page of the changes of an entry, newest
+// first, along with the next page token and the number of changes.
+func (l *ChangeLog) FindWordMeaningChanges(
+	ctx context.Context,
+	query WordMeaningChangeQuery,
+) ([]*WordMeaningChange, string, int64, error) {
+	var changes []*WordMeaningChange
+	err := l.db.WalkChanges(func(id string, value []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var head struct {
+			WordMeaningID string `json:"word_meaning_id"`
+		}
+		if err := json.Unmarshal(value, &head); err != nil {
+			return fmt.Errorf("change %q: %w", id, err)
+		}
+		if head.WordMeaningID != query.WordMeaningID {
+			return nil
+		}
+		change, err := decodeChange(id, value)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, "", 0, err
+	}
+	sortBy(changes, newestFirst, (*WordMeaningChange).cursor)
+
+	page, next, err := paginate(changes, query.Page, newestFirst, (*WordMeaningChange).cursor)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return page, next, int64(len(changes)), nil
+}
+
+func encodeChange(change *WordMeaningChange) ([]byte, error) {
+	record := changeRecord{
+		WordMeaningID:    change.WordMeaningID,
+		UserID:           change.UserID,
+		Action:           change.Action,
+		Diff:             change.Diff,
+		RevertedChangeID: change.RevertedChangeID,
+		CreatedAt:        change.CreatedAt,
+	}
+	var err error
+	if change.Before != nil {
+		if record.Before, err = protojson.Marshal(change.Before); err != nil {
+			return nil, err
+		}
+	}
+	if change.After != nil {
+		if record.After, err = protojson.Marshal(change.After); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(record)
+}
+
+func decodeChange(id string, value []byte) (*WordMeaningChange, error) {
+	var record changeRecord
+	if err := json.Unmarshal(value, &record); err != nil {
+		return nil, fmt.Errorf("change %q: %w", id, err)
+	}
+	change := &WordMeaningChange{
+		ID:               id,
+		WordMeaningID:    record.WordMeaningID,
+		UserID:           record.UserID,
+		Action:           record.Action,
+		Diff:             record.Diff,
+		RevertedChangeID: record.RevertedChangeID,
+		CreatedAt:        record.CreatedAt,
+	}
+	if record.Before != nil {
+		change.Before = &pb.WordMeaning{}
+		if err := protojson.Unmarshal(record.Before, change.Before); err != nil {
+			return nil, fmt.Errorf("change %q: before: %w", id, err)
+		}
+	}
+	if record.After != nil {
+		change.After = &pb.WordMeaning{}
+		if err := protojson.Unmarshal(record.After, change.After); err != nil {
+			return nil, fmt.Errorf("change %q: after: %w", id, err)
+		}
+	}
+	return change, nil
+}
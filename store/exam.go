@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// Exam is a vocabulary test authored by a user.
+type Exam struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"user_id"`
+	Topic       string    `json:"topic"`
+	Description string    `json:"description,omitempty"`
+	IsPublic    bool      `json:"is_public,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func (e *Exam) cursor() cursor {
+	return newCursor(e.CreatedAt, e.ID)
+}
+
+// CreateExam saves a new exam, assigning its id and timestamps.
+func (m *Memory) CreateExam(_ context.Context, exam *Exam) (*Exam, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	saved := *exam
+	saved.ID = NewID()
+	saved.CreatedAt = m.now()
+	saved.UpdatedAt = saved.CreatedAt
+	if err := m.persist(putRecord(kindExam, saved.ID, &saved)); err != nil {
+		return nil, err
+	}
+	m.exams[saved.ID] = &saved
+
+	copied := saved
+	return &copied, nil
+}
+
+// UpdateExam replaces the topic, description and visibility of an exam owned
+// by exam.UserID.
+func (m *Memory) UpdateExam(_ context.Context, exam *Exam) (*Exam, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	former, ok := m.exams[exam.ID]
+	if !ok || former.UserID != exam.UserID {
+		return nil, ErrNotFound
+	}
+	saved := *former
+	saved.Topic = exam.Topic
+	saved.Description = exam.Description
+	saved.IsPublic = exam.IsPublic
+	saved.UpdatedAt = m.now()
+	if err := m.persist(putRecord(kindExam, saved.ID, &saved)); err != nil {
+		return nil, err
+	}
+	m.exams[saved.ID] = &saved
+
+	copied := saved
+	return &copied, nil
+}
+
+// DeleteExam removes the exam id owned by userID together with its questions
+// and attempts.
+func (m *Memory) DeleteExam(_ context.Context, userID, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exam, ok := m.exams[id]
+	if !ok || exam.UserID != userID {
+		return ErrNotFound
+	}
+	var questions []*Question
+	for _, question := range m.questions {
+		if question.ExamID == id {
+			questions = append(questions, question)
+		}
+	}
+	var records []*ExamRecord
+	for _, record := range m.examRecords {
+		if record.ExamID == id {
+			records = append(records, record)
+		}
+	}
+
+	writes := []write{deleteRecord(kindExam, id)}
+	wordMeaningIDs := make([]string, 0, len(questions))
+	for _, question := range questions {
+		writes = append(writes, deleteRecord(kindQuestion, question.ID))
+		wordMeaningIDs = append(wordMeaningIDs, question.WordMeaningID)
+	}
+	writes = append(writes, m.releaseWrites(wordMeaningIDs...)...)
+	for _, record := range records {
+		writes = append(writes, deleteRecord(kindExamRecord, record.ID))
+	}
+	if err := m.persist(writes...); err != nil {
+		return err
+	}
+
+	delete(m.exams, id)
+	for _, question := range questions {
+		delete(m.questions, question.ID)
+		m.releaseWordMeaning(question.WordMeaningID)
+	}
+	for _, record := range records {
+		delete(m.examRecords, record.ID)
+	}
+	return nil
+}
+
+// FindExam returns the exam id if userID owns it or it is public.
+func (m *Memory) FindExam(_ context.Context, userID, id string) (*Exam, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	exam, ok := m.exams[id]
+	if !ok || (exam.UserID != userID && !exam.IsPublic) {
+		return nil, ErrNotFound
+	}
+	copied := *exam
+	return &copied, nil
+}
+
+// ExamQuery selects the exams listed by FindExams.
+type ExamQuery struct {
+	UserID string
+	// IncludePublic also lists the public exams of other users.
+	IncludePublic bool
+	Page          Page
+}
+
+// FindExams returns a page of the exams matching query, newest first, along
+// with the next page token and the number of matching exams.
+func (m *Memory) FindExams(_ context.Context, query ExamQuery) ([]*Exam, string, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var exams []*Exam
+	for _, exam := range m.exams {
+		if exam.UserID != query.UserID && !(query.IncludePublic && exam.IsPublic) {
+			continue
+		}
+		copied := *exam
+		exams = append(exams, &copied)
+	}
+	sortBy(exams, newestFirst, (*Exam).cursor)
+
+	page, next, err := paginate(exams, query.Page, newestFirst, (*Exam).cursor)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return page, next, int64(len(exams)), nil
+}
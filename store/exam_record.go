@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// ExamRecord is one attempt of a user at an exam.
+type ExamRecord struct {
+	ID     string `json:"id"`
+	ExamID string `json:"exam_id"`
+	UserID string `json:"user_id"`
+	// Score is the percentage of questions answered correctly.
+	Score         int32            `json:"score"`
+	CorrectCount  int32            `json:"correct_count"`
+	QuestionCount int32            `json:"question_count"`
+	Results       []QuestionResult `json:"results"`
+	StartedAt     time.Time        `json:"started_at"`
+	// CreatedAt is when the attempt was submitted.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// QuestionResult is the outcome of one question of an attempt.
+type QuestionResult struct {
+	QuestionID    string `json:"question_id"`
+	Answer        string `json:"answer"`
+	CorrectAnswer string `json:"correct_answer"`
+	IsCorrect     bool   `json:"is_correct"`
+}
+
+func (r *ExamRecord) cursor() cursor {
+	return newCursor(r.CreatedAt, r.ID)
+}
+
+func (r *ExamRecord) clone() *ExamRecord {
+	copied := *r
+	copied.Results = slices.Clone(r.Results)
+	return &copied
+}
+
+// ExamQuestions returns every question of an exam, oldest first. The exam
+// must be owned by userID or be public.
+func (m *Memory) ExamQuestions(_ context.Context, userID, examID string) ([]*Question, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	exam, ok := m.exams[examID]
+	if !ok || (exam.UserID != userID && !exam.IsPublic) {
+		return nil, ErrNotFound
+	}
+
+	var questions []*Question
+	for _, question := range m.questions {
+		if question.ExamID == examID {
+			questions = append(questions, question.clone())
+		}
+	}
+	sortBy(questions, oldestFirst, (*Question).cursor)
+	return questions, nil
+}
+
+// CreateExamRecord saves an attempt, assigning its id and submission time.
+func (m *Memory) CreateExamRecord(_ context.Context, record *ExamRecord) (*ExamRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.exams[record.ExamID]; !ok {
+		return nil, ErrNotFound
+	}
+
+	saved := record.clone()
+	saved.ID = NewID()
+	saved.CreatedAt = m.now()
+	if saved.StartedAt.IsZero() || saved.StartedAt.After(saved.CreatedAt) {
+		saved.StartedAt = saved.CreatedAt
+	}
+	if err := m.persist(putRecord(kindExamRecord, saved.ID, saved)); err != nil {
+		return nil, err
+	}
+	m.examRecords[saved.ID] = saved
+	return saved.clone(), nil
+}
+
+// ExamRecordQuery selects the attempts listed by FindExamRecords.
+type ExamRecordQuery struct {
+	UserID string
+	ExamID string
+	Page   Page
+}
+
+// FindExamRecords returns a page of the attempts of query.UserID at an exam,
+// newest first, along with the next page token and the number of attempts.
+func (m *Memory) FindExamRecords(
+	_ context.Context,
+	query ExamRecordQuery,
+) ([]*ExamRecord, string, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	records := m.examRecordsOf(query.UserID, query.ExamID)
+	sortBy(records, newestFirst, (*ExamRecord).cursor)
+
+	page, next, err := paginate(records, query.Page, newestFirst, (*ExamRecord).cursor)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return page, next, int64(len(records)), nil
+}
+
+// ExamScores returns every attempt of userID at an exam, oldest first, with
+// Results left out.
+func (m *Memory) ExamScores(_ context.Context, userID, examID string) ([]*ExamRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	records := m.examRecordsOf(userID, examID)
+	for _, record := range records {
+		record.Results = nil
+	}
+	sortBy(records, oldestFirst, (*ExamRecord).cursor)
+	return records, nil
+}
+
+func (m *Memory) examRecordsOf(userID, examID string) []*ExamRecord {
+	var records []*ExamRecord
+	for _, record := range m.examRecords {
+		if record.UserID == userID && record.ExamID == examID {
+			records = append(records, record.clone())
+		}
+	}
+	return records
+}
@@ -0,0 +1,201 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Favorite is a word meaning a user has saved.
+type Favorite struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"user_id"`
+	WordMeaningID string    `json:"word_meaning_id"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// favoriteKey identifies the favorite of a user for a word meaning.
+type favoriteKey struct {
+	userID        string
+	wordMeaningID string
+}
+
+// CreateFavorite saves wordMeaningID for userID. Saving the same meaning twice
+// returns the existing favorite.
+func (m *Memory) CreateFavorite(
+	_ context.Context,
+	userID, wordMeaningID string,
+) (*Favorite, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.wordMeanings[wordMeaningID]; !ok {
+		return nil, ErrNotFound
+	}
+	if favorite, ok := m.savedFavorites[favoriteKey{userID, wordMeaningID}]; ok {
+		copied := *favorite
+		return &copied, nil
+	}
+
+	favorite := &Favorite{
+		ID:            NewID(),
+		UserID:        userID,
+		WordMeaningID: wordMeaningID,
+		CreatedAt:     m.now(),
+	}
+	writes := append(m.retainWrites(wordMeaningID), putRecord(kindFavorite, favorite.ID, favorite))
+	if err := m.persist(writes...); err != nil {
+		return nil, err
+	}
+	m.addFavorite(favorite)
+	m.retainWordMeaning(wordMeaningID)
+	copied := *favorite
+	return &copied, nil
+}
+
+// DeleteFavorite removes the favorite id owned by userID together with its
+// review schedule.
+func (m *Memory) DeleteFavorite(_ context.Context, userID, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	favorite, ok := m.favorites[id]
+	if !ok || favorite.UserID != userID {
+		return ErrNotFound
+	}
+	writes := append(m.releaseWrites(favorite.WordMeaningID),
+		deleteRecord(kindFavorite, id),
+		deleteRecord(kindReview, id),
+	)
+	if err := m.persist(writes...); err != nil {
+		return err
+	}
+	m.removeFavorite(favorite)
+	delete(m.reviews, id)
+	m.releaseWordMeaning(favorite.WordMeaningID)
+	return nil
+}
+
+// addFavorite stores favorite and indexes it. m.mu must be held for writing.
+func (m *Memory) addFavorite(favorite *Favorite) {
+	m.favorites[favorite.ID] = favorite
+	m.savedFavorites[favoriteKey{favorite.UserID, favorite.WordMeaningID}] = favorite
+	favorites := m.userFavorites[favorite.UserID]
+	i := favoritePosition(favorites, favorite.cursor())
+	m.userFavorites[favorite.UserID] = slices.Insert(favorites, i, favorite)
+}
+
+// removeFavorite removes favorite and its index entries. m.mu must be held
+// for writing.
+func (m *Memory) removeFavorite(favorite *Favorite) {
+	delete(m.favorites, favorite.ID)
+	delete(m.savedFavorites, favoriteKey{favorite.UserID, favorite.WordMeaningID})
+	favorites := m.userFavorites[favorite.UserID]
+	i := favoritePosition(favorites, favorite.cursor())
+	if i < len(favorites) && favorites[i] == favorite {
+		favorites = slices.Delete(favorites, i, i+1)
+	}
+	if len(favorites) == 0 {
+		delete(m.userFavorites, favorite.UserID)
+	} else {
+		m.userFavorites[favorite.UserID] = favorites
+	}
+}
+
+// favoritePosition returns the index of the first of favorites, sorted
+// oldest first, that does not come before c.
+func favoritePosition(favorites []*Favorite, c cursor) int {
+	return sort.Search(len(favorites), func(i int) bool {
+		return !oldestFirst.less(favorites[i].cursor(), c)
+	})
+}
+
+// FavoriteQuery selects the favorites listed by FindFavorites.
+type FavoriteQuery struct {
+	UserID string
+	// Word, when set, keeps only favorites whose headword starts with it.
+	Word string
+	Page Page
+}
+
+// FindFavorites returns a page of the favorites matching query, newest first,
+// along with the next page token and the number of matching favorites. The
+// page is read from the end of the user's favorites backwards, starting from
+// the token's position, found by binary search.
+func (m *Memory) FindFavorites(
+	_ context.Context,
+	query FavoriteQuery,
+) ([]*Favorite, string, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	favorites := m.userFavorites[query.UserID]
+	end := len(favorites)
+	if query.Page.Token != "" {
+		after, err := decodeCursor(query.Page.Token)
+		if err != nil {
+			return nil, "", 0, err
+		}
+		end = favoritePosition(favorites, after)
+	}
+
+	prefix := strings.ToLower(query.Word)
+	matches := func(favorite *Favorite) bool {
+		if prefix == "" {
+			return true
+		}
+		word := strings.ToLower(m.wordMeanings[favorite.WordMeaningID].GetWord())
+		return strings.HasPrefix(word, prefix)
+	}
+
+	size := query.Page.size()
+	page := make([]*Favorite, 0, min(size, end))
+	var next string
+	for i := end - 1; i >= 0; i-- {
+		if !matches(favorites[i]) {
+			continue
+		}
+		if len(page) == size {
+			next = page[len(page)-1].cursor().encode()
+			break
+		}
+		copied := *favorites[i]
+		page = append(page, &copied)
+	}
+
+	total := int64(len(favorites))
+	if prefix != "" {
+		total = 0
+		for _, favorite := range favorites {
+			if matches(favorite) {
+				total++
+			}
+		}
+	}
+	return page, next, total, nil
+}
+
+func (f *Favorite) cursor() cursor {
+	return newCursor(f.CreatedAt, f.ID)
+}
+
+// FavoriteIDs maps each of wordMeaningIDs that userID has saved to the id of
+// the favorite.
+func (m *Memory) FavoriteIDs(
+	_ context.Context,
+	userID string,
+	wordMeaningIDs []string,
+) (map[string]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := make(map[string]string)
+	for _, wordMeaningID := range wordMeaningIDs {
+		if favorite, ok := m.savedFavorites[favoriteKey{userID, wordMeaningID}]; ok {
+			ids[wordMeaningID] = favorite.ID
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+func TestFindFavorites(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory()
+	now := time.Unix(1700000000, 0)
+	m.now = func() time.Time { return now }
+
+	// Nine favorites of u1, three per instant, on apple, banana and
+	// cherry in turn; u2 saves the same meanings.
+	words := []string{"apple", "banana", "cherry"}
+	var favorites []*Favorite
+	for i := 0; i < 9; i++ {
+		id := fmt.Sprintf("wm%d", i)
+		err := m.SaveWordMeanings(ctx, []*pb.WordMeaning{{Id: id, Word: words[i%3]}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		favorite, err := m.CreateFavorite(ctx, "u1", id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		favorites = append(favorites, favorite)
+		if _, err := m.CreateFavorite(ctx, "u2", id); err != nil {
+			t.Fatal(err)
+		}
+		if i%3 == 2 {
+			now = now.Add(time.Second)
+		}
+	}
+	deleted := favorites[4].ID
+	if err := m.DeleteFavorite(ctx, "u1", deleted); err != nil {
+		t.Fatal(err)
+	}
+
+	// Favorites saved in the same instant are ordered by id, which is
+	// random, so the expected listing is sorted the same way.
+	sortBy(favorites, newestFirst, (*Favorite).cursor)
+	listed := func(words ...string) []string {
+		var ids []string
+		for _, favorite := range favorites {
+			if favorite.ID == deleted {
+				continue
+			}
+			word := m.wordMeanings[favorite.WordMeaningID].GetWord()
+			if len(words) == 0 || word == words[0] {
+				ids = append(ids, favorite.ID)
+			}
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name string
+		word string
+		size int
+		want []string
+	}{
+		{"all, in pages", "", 2, listed()},
+		{"all, in one page", "", 100, listed()},
+		{"by headword prefix", "B", 2, listed("banana")},
+		{"no match", "durian", 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			query := FavoriteQuery{UserID: "u1", Word: tt.word, Page: Page{Size: tt.size}}
+			for {
+				page, next, total, err := m.FindFavorites(ctx, query)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if total != int64(len(tt.want)) {
+					t.Errorf("total = %d, want %d", total, len(tt.want))
+				}
+				if len(page) > tt.size {
+					t.Errorf("page of %d favorites, want at most %d", len(page), tt.size)
+				}
+				for _, favorite := range page {
+					got = append(got, favorite.ID)
+				}
+				if next == "" {
+					break
+				}
+				query.Page.Token = next
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("favorites = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/dictdb"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/srs"
+)
+
+// maxUnreferencedWordMeanings caps the saved word meanings no user data
+// refers to.
+const maxUnreferencedWordMeanings = 50000
+
+// Memory is a Store that keeps everything in process memory, and, when made
+// by NewPersistent, its user data in a dictionary file too.
+type Memory struct {
+	mu  sync.RWMutex
+	now func() time.Time
+	db  *dictdb.DB
+
+	// wordMeanings holds every word meaning user data refers to, and the
+	// most recently saved of the others, which unreferenced orders least
+	// recently saved last. references counts the favorites and questions
+	// referring to each meaning.
+	wordMeanings         map[string]*pb.WordMeaning
+	unreferenced         *list.List
+	unreferencedElements map[string]*list.Element
+	references           map[string]int
+
+	favorites map[string]*Favorite
+	// userFavorites holds the favorites of each user oldest first, ordered
+	// as a listing by creation time; savedFavorites indexes them by user
+	// and saved meaning.
+	userFavorites  map[string][]*Favorite
+	savedFavorites map[favoriteKey]*Favorite
+
+	exams       map[string]*Exam
+	questions   map[string]*Question
+	examRecords map[string]*ExamRecord
+	reviews     map[string]srs.Card
+
+	wordMeaningChanges map[string]*WordMeaningChange
+}
+
+// NewMemory returns an empty Memory store.
+func NewMemory() *Memory {
+	return &Memory{
+		now:                  time.Now,
+		wordMeanings:         make(map[string]*pb.WordMeaning),
+		unreferenced:         list.New(),
+		unreferencedElements: make(map[string]*list.Element),
+		references:           make(map[string]int),
+		favorites:            make(map[string]*Favorite),
+		userFavorites:        make(map[string][]*Favorite),
+		savedFavorites:       make(map[favoriteKey]*Favorite),
+		exams:                make(map[string]*Exam),
+		questions:            make(map[string]*Question),
+		examRecords:          make(map[string]*ExamRecord),
+		reviews:              make(map[string]srs.Card),
+
+		wordMeaningChanges: make(map[string]*WordMeaningChange),
+	}
+}
+
+// SaveWordMeanings records wordMeanings by id so user data can refer to them.
+// Meanings user data refers to are kept as long as it does, the others only
+// while they are among the maxUnreferencedWordMeanings most recently saved.
+func (m *Memory) SaveWordMeanings(_ context.Context, wordMeanings []*pb.WordMeaning) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	saved := make([]*pb.WordMeaning, 0, len(wordMeanings))
+	var writes []write
+	for _, wordMeaning := range wordMeanings {
+		if wordMeaning.GetId() == "" {
+			continue
+		}
+		wordMeaning = savedWordMeaning(wordMeaning)
+		saved = append(saved, wordMeaning)
+		// An edit of a meaning user data refers to reaches the file too.
+		id := wordMeaning.GetId()
+		if m.db != nil && m.references[id] > 0 && !proto.Equal(m.wordMeanings[id], wordMeaning) {
+			writes = append(writes, putRecord(kindWordMeaning, id, wordMeaning))
+		}
+	}
+	if err := m.persist(writes...); err != nil {
+		return err
+	}
+
+	for _, saved := range saved {
+		id := saved.GetId()
+		_, known := m.wordMeanings[id]
+		m.wordMeanings[id] = saved
+
+		if element, ok := m.unreferencedElements[id]; ok {
+			m.unreferenced.MoveToFront(element)
+		} else if !known && m.references[id] == 0 {
+			m.unreferencedElements[id] = m.unreferenced.PushFront(id)
+		}
+	}
+
+	m.evictUnreferenced()
+	return nil
+}
+
+// evictUnreferenced drops the least recently saved word meanings no user
+// data refers to beyond maxUnreferencedWordMeanings. m.mu must be held for
+// writing.
+func (m *Memory) evictUnreferenced() {
+	for m.unreferenced.Len() > maxUnreferencedWordMeanings {
+		id := m.unreferenced.Remove(m.unreferenced.Back()).(string)
+		delete(m.unreferencedElements, id)
+		delete(m.wordMeanings, id)
+	}
+}
+
+// retainWordMeaning counts a reference of user data to the saved word
+// meaning id, which keeps it until releaseWordMeaning. m.mu must be held for
+// writing.
+func (m *Memory) retainWordMeaning(id string) {
+	if id == "" {
+		return
+	}
+	m.references[id]++
+	if element, ok := m.unreferencedElements[id]; ok {
+		m.unreferenced.Remove(element)
+		delete(m.unreferencedElements, id)
+	}
+}
+
+// releaseWordMeaning drops a reference counted by retainWordMeaning. A
+// meaning nothing refers to any more joins the others as the most recently
+// saved. m.mu must be held for writing.
+func (m *Memory) releaseWordMeaning(id string) {
+	if id == "" || m.references[id] == 0 {
+		return
+	}
+	if m.references[id]--; m.references[id] > 0 {
+		return
+	}
+	delete(m.references, id)
+	if _, ok := m.wordMeanings[id]; ok {
+		m.unreferencedElements[id] = m.unreferenced.PushFront(id)
+		m.evictUnreferenced()
+	}
+}
+
+// FindWordMeaning returns the word meaning saved under id.
+func (m *Memory) FindWordMeaning(_ context.Context, id string) (*pb.WordMeaning, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wordMeaning, ok := m.wordMeanings[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return proto.Clone(wordMeaning).(*pb.WordMeaning), nil
+}
+
+// SampleWordMeanings returns up to n saved word meanings in no particular
+// order.
+func (m *Memory) SampleWordMeanings(_ context.Context, n int) ([]*pb.WordMeaning, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wordMeanings := make([]*pb.WordMeaning, 0, min(n, len(m.wordMeanings)))
+	for _, wordMeaning := range m.wordMeanings {
+		if len(wordMeanings) == n {
+			break
+		}
+		wordMeanings = append(wordMeanings, proto.Clone(wordMeaning).(*pb.WordMeaning))
+	}
+	return wordMeanings, nil
+}
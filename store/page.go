@@ -0,0 +1,117 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+)
+
+const (
+	// DefaultPageSize is used when a Page does not set Size.
+	DefaultPageSize = 20
+	// MaxPageSize caps Page.Size.
+	MaxPageSize = 100
+)
+
+// ErrInvalidPageToken is returned for a page token the store did not issue.
+var ErrInvalidPageToken = errors.New("store: invalid page token")
+
+// Page selects a window of a listing. Token is the opaque value returned as
+// the next page token of the previous window; it is empty for the first one.
+type Page struct {
+	Size  int
+	Token string
+}
+
+func (p Page) size() int {
+	switch {
+	case p.Size <= 0:
+		return DefaultPageSize
+	case p.Size > MaxPageSize:
+		return MaxPageSize
+	default:
+		return p.Size
+	}
+}
+
+// order is the direction of a listing ordered by creation time, then by id.
+type order int
+
+const (
+	newestFirst order = iota
+	oldestFirst
+)
+
+// cursor is the position of the last record of a page in a listing.
+type cursor struct {
+	CreatedAt int64  `json:"t"`
+	ID        string `json:"i"`
+}
+
+func newCursor(createdAt time.Time, id string) cursor {
+	return cursor{CreatedAt: createdAt.UnixNano(), ID: id}
+}
+
+// less reports whether a sorts before b in a listing in order o.
+func (o order) less(a, b cursor) bool {
+	if a.CreatedAt == b.CreatedAt {
+		if o == newestFirst {
+			return a.ID > b.ID
+		}
+		return a.ID < b.ID
+	}
+	if o == newestFirst {
+		return a.CreatedAt > b.CreatedAt
+	}
+	return a.CreatedAt < b.CreatedAt
+}
+
+// sortBy sorts items in order o.
+func sortBy[T any](items []T, o order, key func(T) cursor) {
+	sort.Slice(items, func(i, j int) bool {
+		return o.less(key(items[i]), key(items[j]))
+	})
+}
+
+func (c cursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(token string) (cursor, error) {
+	var c cursor
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return c, ErrInvalidPageToken
+	}
+	return c, nil
+}
+
+// paginate returns the window of items, which must be sorted in order o,
+// selected by page together with the token of the following window.
+func paginate[T any](items []T, page Page, o order, key func(T) cursor) ([]T, string, error) {
+	start := 0
+	if page.Token != "" {
+		after, err := decodeCursor(page.Token)
+		if err != nil {
+			return nil, "", err
+		}
+		for start < len(items) && !o.less(after, key(items[start])) {
+			start++
+		}
+	}
+
+	end := min(start+page.size(), len(items))
+	window := items[start:end]
+
+	var next string
+	if end < len(items) {
+		next = key(items[end-1]).encode()
+	}
+	return window, next, nil
+}
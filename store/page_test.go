@@ -0,0 +1,145 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type item struct {
+	id        string
+	createdAt time.Time
+}
+
+func (i item) cursor() cursor {
+	return newCursor(i.createdAt, i.id)
+}
+
+func TestCursorToken(t *testing.T) {
+	c := newCursor(time.Unix(1700000000, 123), "a1")
+	got, err := decodeCursor(c.encode())
+	if err != nil || got != c {
+		t.Errorf("decodeCursor(encode(%v)) = %v, %v", c, got, err)
+	}
+
+	for _, token := range []string{
+		"not base64!",
+		"bm90IGpzb24", // "not json"
+		"eyJ0IjoxfQ",  // {"t":1}, without an id
+	} {
+		if _, err := decodeCursor(token); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("decodeCursor(%q) error = %v, want %v", token, err, ErrInvalidPageToken)
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	// Items created in the same instant are ordered by id.
+	base := time.Unix(1700000000, 0)
+	var items []item
+	for i := 0; i < 7; i++ {
+		items = append(items, item{
+			id:        fmt.Sprintf("i%d", i),
+			createdAt: base.Add(time.Duration(i/3) * time.Second),
+		})
+	}
+
+	tests := []struct {
+		name  string
+		order order
+		size  int
+		want  [][]string
+	}{
+		{
+			name:  "newest first across equal times",
+			order: newestFirst,
+			size:  2,
+			want:  [][]string{{"i6", "i5"}, {"i4", "i3"}, {"i2", "i1"}, {"i0"}},
+		},
+		{
+			name:  "oldest first across equal times",
+			order: oldestFirst,
+			size:  3,
+			want:  [][]string{{"i0", "i1", "i2"}, {"i3", "i4", "i5"}, {"i6"}},
+		},
+		{
+			name:  "exact last page",
+			order: oldestFirst,
+			size:  7,
+			want:  [][]string{{"i0", "i1", "i2", "i3", "i4", "i5", "i6"}},
+		},
+		{
+			name:  "default size",
+			order: newestFirst,
+			size:  0,
+			want:  [][]string{{"i6", "i5", "i4", "i3", "i2", "i1", "i0"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := append([]item(nil), items...)
+			sortBy(sorted, tt.order, item.cursor)
+
+			page := Page{Size: tt.size}
+			for i, want := range tt.want {
+				got, next, err := paginate(sorted, page, tt.order, item.cursor)
+				if err != nil {
+					t.Fatalf("page %d: %v", i, err)
+				}
+				if ids := itemIDs(got); fmt.Sprint(ids) != fmt.Sprint(want) {
+					t.Errorf("page %d = %v, want %v", i, ids, want)
+				}
+				if last := i == len(tt.want)-1; last != (next == "") {
+					t.Fatalf("page %d: next token %q, last page %v", i, next, last)
+				}
+				page.Token = next
+			}
+		})
+	}
+}
+
+func TestPaginateAfterRemoval(t *testing.T) {
+	// A token stays valid when the item it ends on is gone.
+	base := time.Unix(1700000000, 0)
+	items := []item{{"a", base}, {"b", base}, {"c", base}, {"d", base}}
+	_, next, err := paginate(items, Page{Size: 2}, oldestFirst, item.cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items = append(items[:1], items[2:]...) // b is deleted.
+	got, _, err := paginate(items, Page{Size: 2, Token: next}, oldestFirst, item.cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := itemIDs(got); fmt.Sprint(ids) != "[c d]" {
+		t.Errorf("page after b = %v, want [c d]", ids)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		size int
+		want int
+	}{
+		{-1, DefaultPageSize},
+		{0, DefaultPageSize},
+		{1, 1},
+		{MaxPageSize, MaxPageSize},
+		{MaxPageSize + 1, MaxPageSize},
+	}
+	for _, tt := range tests {
+		if got := (Page{Size: tt.size}).size(); got != tt.want {
+			t.Errorf("Page{Size: %d}.size() = %d, want %d", tt.size, got, tt.want)
+		}
+	}
+}
+
+func itemIDs(items []item) []string {
+	ids := make([]string, 0, len(items))
+	for _, i := range items {
+		ids = append(ids, i.id)
+	}
+	return ids
+}
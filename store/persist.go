@@ -0,0 +1,209 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/dictdb"
+	"github.com/kakurineuin/learn-english-word/pb"
+	"github.com/kakurineuin/learn-english-word/srs"
+)
+
+// Kinds of the records a persistent Memory keeps in its file.
+const (
+	kindWordMeaning = "word_meaning"
+	kindFavorite    = "favorite"
+	kindExam        = "exam"
+	kindQuestion    = "question"
+	kindExamRecord  = "exam_record"
+	kindReview      = "review"
+)
+
+// NewPersistent returns a Memory store that keeps its user data in db as
+// well. Every change is written to the file before it is made in memory,
+// and the records already in the file are loaded first, so user data
+// outlives the process. Saved word meanings are kept in the file while user
+// data refers to them. The revision history of dictionary entries is not
+// kept; a ChangeLog on the same file does that.
+func NewPersistent(db *dictdb.DB) (*Memory, error) {
+	m := NewMemory()
+	if err := m.load(db); err != nil {
+		return nil, err
+	}
+	m.db = db
+	return m, nil
+}
+
+// reviewRecord is the form of the review schedule of a favorite in the file.
+type reviewRecord struct {
+	EaseFactor     float64   `json:"ease_factor"`
+	IntervalDays   int       `json:"interval_days"`
+	Repetitions    int       `json:"repetitions"`
+	DueAt          time.Time `json:"due_at"`
+	LastReviewedAt time.Time `json:"last_reviewed_at"`
+}
+
+// write is a record to write to the file of a persistent Memory: a
+// *pb.WordMeaning, stored in its protojson form, or a value stored as
+// JSON. A nil value deletes the record.
+type write struct {
+	kind  string
+	id    string
+	value any
+}
+
+func putRecord(kind, id string, value any) write {
+	return write{kind: kind, id: id, value: value}
+}
+
+func deleteRecord(kind, id string) write {
+	return write{kind: kind, id: id}
+}
+
+// persist writes records to the file of a persistent Memory, in one
+// transaction, and does nothing for a Memory without one. m.mu must be held
+// for writing.
+func (m *Memory) persist(writes ...write) error {
+	if m.db == nil || len(writes) == 0 {
+		return nil
+	}
+	records := make([]dictdb.Record, 0, len(writes))
+	for _, w := range writes {
+		record := dictdb.Record{Kind: w.kind, ID: w.id}
+		var err error
+		switch value := w.value.(type) {
+		case nil:
+		case *pb.WordMeaning:
+			record.Value, err = protojson.Marshal(value)
+		default:
+			record.Value, err = json.Marshal(value)
+		}
+		if err != nil {
+			return fmt.Errorf("encode %s %q: %w", w.kind, w.id, err)
+		}
+		records = append(records, record)
+	}
+	return m.db.WriteRecords(records...)
+}
+
+// retainWrites returns the write keeping the saved word meaning id in the
+// file once user data starts referring to it. m.mu must be held.
+func (m *Memory) retainWrites(id string) []write {
+	if m.db == nil || id == "" || m.references[id] > 0 {
+		return nil
+	}
+	wordMeaning, ok := m.wordMeanings[id]
+	if !ok {
+		return nil
+	}
+	return []write{putRecord(kindWordMeaning, id, wordMeaning)}
+}
+
+// releaseWrites returns the writes dropping from the file the saved word
+// meanings no user data refers to once a reference to each of ids is
+// released. m.mu must be held.
+func (m *Memory) releaseWrites(ids ...string) []write {
+	if m.db == nil {
+		return nil
+	}
+	released := make(map[string]int)
+	for _, id := range ids {
+		if id != "" {
+			released[id]++
+		}
+	}
+	var writes []write
+	for id, n := range released {
+		if references := m.references[id]; references > 0 && references <= n {
+			writes = append(writes, deleteRecord(kindWordMeaning, id))
+		}
+	}
+	return writes
+}
+
+// load reads the records of db into m.
+func (m *Memory) load(db *dictdb.DB) error {
+	err := db.WalkRecords(kindWordMeaning, func(id string, value []byte) error {
+		wordMeaning := &pb.WordMeaning{}
+		if err := protojson.Unmarshal(value, wordMeaning); err != nil {
+			return err
+		}
+		m.wordMeanings[id] = wordMeaning
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("load word meanings: %w", err)
+	}
+
+	err = walkRecords(db, kindExam, func(exam *Exam) {
+		m.exams[exam.ID] = exam
+	})
+	if err != nil {
+		return fmt.Errorf("load exams: %w", err)
+	}
+	err = walkRecords(db, kindQuestion, func(question *Question) {
+		m.questions[question.ID] = question
+		m.retainWordMeaning(question.WordMeaningID)
+	})
+	if err != nil {
+		return fmt.Errorf("load questions: %w", err)
+	}
+	err = walkRecords(db, kindFavorite, func(favorite *Favorite) {
+		m.addFavorite(favorite)
+		m.retainWordMeaning(favorite.WordMeaningID)
+	})
+	if err != nil {
+		return fmt.Errorf("load favorites: %w", err)
+	}
+	err = walkRecords(db, kindExamRecord, func(record *ExamRecord) {
+		m.examRecords[record.ID] = record
+	})
+	if err != nil {
+		return fmt.Errorf("load exam records: %w", err)
+	}
+	err = db.WalkRecords(kindReview, func(id string, value []byte) error {
+		var record reviewRecord
+		if err := json.Unmarshal(value, &record); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		m.reviews[id] = srs.Card(record)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("load reviews: %w", err)
+	}
+
+	// A meaning left in the file by a crash after its last reference went
+	// is kept like any other saved one.
+	for id := range m.wordMeanings {
+		if m.references[id] == 0 {
+			m.unreferencedElements[id] = m.unreferenced.PushFront(id)
+		}
+	}
+	m.evictUnreferenced()
+	return nil
+}
+
+// walkRecords calls fn with every record of kind in db, decoded from JSON.
+func walkRecords[T any](db *dictdb.DB, kind string, fn func(*T)) error {
+	return db.WalkRecords(kind, func(id string, value []byte) error {
+		record := new(T)
+		if err := json.Unmarshal(value, record); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		fn(record)
+		return nil
+	})
+}
+
+// savedWordMeaning returns the copy of wordMeaning that SaveWordMeanings
+// keeps.
+func savedWordMeaning(wordMeaning *pb.WordMeaning) *pb.WordMeaning {
+	saved := proto.Clone(wordMeaning).(*pb.WordMeaning)
+	saved.FavoriteWordMeaningId = ""
+	return saved
+}
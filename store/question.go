@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// Question is a multiple-choice question of an exam.
+type Question struct {
+	ID            string          `json:"id"`
+	ExamID        string          `json:"exam_id"`
+	UserID        string          `json:"user_id"`
+	Type          pb.QuestionType `json:"type"`
+	Ask           string          `json:"ask"`
+	Answers       []string        `json:"answers"`
+	CorrectAnswer string          `json:"correct_answer"`
+	// WordMeaningID is the dictionary meaning the question was written for,
+	// if any.
+	WordMeaningID string    `json:"word_meaning_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func (q *Question) cursor() cursor {
+	return newCursor(q.CreatedAt, q.ID)
+}
+
+func (q *Question) clone() *Question {
+	copied := *q
+	copied.Answers = slices.Clone(q.Answers)
+	return &copied
+}
+
+// CreateQuestion adds a question to an exam owned by question.UserID,
+// assigning its id and timestamps.
+func (m *Memory) CreateQuestion(_ context.Context, question *Question) (*Question, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exam, ok := m.exams[question.ExamID]
+	if !ok || exam.UserID != question.UserID {
+		return nil, ErrNotFound
+	}
+
+	saved := question.clone()
+	saved.ID = NewID()
+	saved.CreatedAt = m.now()
+	saved.UpdatedAt = saved.CreatedAt
+	writes := append(m.retainWrites(saved.WordMeaningID), putRecord(kindQuestion, saved.ID, saved))
+	if err := m.persist(writes...); err != nil {
+		return nil, err
+	}
+	m.questions[saved.ID] = saved
+	m.retainWordMeaning(saved.WordMeaningID)
+	return saved.clone(), nil
+}
+
+// UpdateQuestion replaces the content of a question owned by question.UserID.
+func (m *Memory) UpdateQuestion(_ context.Context, question *Question) (*Question, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	former, ok := m.questions[question.ID]
+	if !ok || former.UserID != question.UserID {
+		return nil, ErrNotFound
+	}
+	saved := former.clone()
+	saved.Ask = question.Ask
+	saved.Answers = slices.Clone(question.Answers)
+	saved.CorrectAnswer = question.CorrectAnswer
+	saved.WordMeaningID = question.WordMeaningID
+	saved.UpdatedAt = m.now()
+
+	writes := []write{putRecord(kindQuestion, saved.ID, saved)}
+	if saved.WordMeaningID != former.WordMeaningID {
+		writes = append(writes, m.retainWrites(saved.WordMeaningID)...)
+		writes = append(writes, m.releaseWrites(former.WordMeaningID)...)
+	}
+	if err := m.persist(writes...); err != nil {
+		return nil, err
+	}
+	m.questions[saved.ID] = saved
+	m.retainWordMeaning(saved.WordMeaningID)
+	m.releaseWordMeaning(former.WordMeaningID)
+	return saved.clone(), nil
+}
+
+// DeleteQuestion removes the question id owned by userID.
+func (m *Memory) DeleteQuestion(_ context.Context, userID, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	question, ok := m.questions[id]
+	if !ok || question.UserID != userID {
+		return ErrNotFound
+	}
+	writes := append(m.releaseWrites(question.WordMeaningID), deleteRecord(kindQuestion, id))
+	if err := m.persist(writes...); err != nil {
+		return err
+	}
+	delete(m.questions, id)
+	m.releaseWordMeaning(question.WordMeaningID)
+	return nil
+}
+
+// QuestionQuery selects the questions listed by FindQuestions.
+type QuestionQuery struct {
+	UserID string
+	ExamID string
+	Page   Page
+}
+
+// FindQuestions returns a page of the questions of an exam, oldest first,
+// along with the next page token and the number of questions. The exam must
+// be owned by query.UserID or be public.
+func (m *Memory) FindQuestions(
+	_ context.Context,
+	query QuestionQuery,
+) ([]*Question, string, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	exam, ok := m.exams[query.ExamID]
+	if !ok || (exam.UserID != query.UserID && !exam.IsPublic) {
+		return nil, "", 0, ErrNotFound
+	}
+
+	var questions []*Question
+	for _, question := range m.questions {
+		if question.ExamID == query.ExamID {
+			questions = append(questions, question.clone())
+		}
+	}
+	sortBy(questions, oldestFirst, (*Question).cursor)
+
+	page, next, err := paginate(questions, query.Page, oldestFirst, (*Question).cursor)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return page, next, int64(len(questions)), nil
+}
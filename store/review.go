@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/kakurineuin/learn-english-word/srs"
+)
+
+// Review is the review schedule of a favorite. A favorite that has never
+// been reviewed is due from the time it was saved.
+type Review struct {
+	FavoriteID    string
+	UserID        string
+	WordMeaningID string
+	Card          srs.Card
+}
+
+// FindReview returns the review schedule of the favorite id owned by userID.
+func (m *Memory) FindReview(_ context.Context, userID, favoriteID string) (*Review, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	favorite, ok := m.favorites[favoriteID]
+	if !ok || favorite.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return m.reviewOf(favorite), nil
+}
+
+// SaveReview stores the schedule of a favorite owned by review.UserID.
+func (m *Memory) SaveReview(_ context.Context, review *Review) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	favorite, ok := m.favorites[review.FavoriteID]
+	if !ok || favorite.UserID != review.UserID {
+		return ErrNotFound
+	}
+	if err := m.persist(putRecord(kindReview, review.FavoriteID, reviewRecord(review.Card))); err != nil {
+		return err
+	}
+	m.reviews[review.FavoriteID] = review.Card
+	return nil
+}
+
+// FindDueReviews returns up to limit reviews of userID due at now, most
+// overdue first, along with the number of reviews due.
+func (m *Memory) FindDueReviews(
+	_ context.Context,
+	userID string,
+	now time.Time,
+	limit int,
+) ([]*Review, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var reviews []*Review
+	for _, favorite := range m.userFavorites[userID] {
+		review := m.reviewOf(favorite)
+		if !review.Card.DueAt.After(now) {
+			reviews = append(reviews, review)
+		}
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		if !reviews[i].Card.DueAt.Equal(reviews[j].Card.DueAt) {
+			return reviews[i].Card.DueAt.Before(reviews[j].Card.DueAt)
+		}
+		return reviews[i].FavoriteID < reviews[j].FavoriteID
+	})
+
+	total := int64(len(reviews))
+	if len(reviews) > limit {
+		reviews = reviews[:limit]
+	}
+	return reviews, total, nil
+}
+
+func (m *Memory) reviewOf(favorite *Favorite) *Review {
+	card, ok := m.reviews[favorite.ID]
+	if !ok {
+		card = srs.NewCard(favorite.CreatedAt)
+	}
+	return &Review{
+		FavoriteID:    favorite.ID,
+		UserID:        favorite.UserID,
+		WordMeaningID: favorite.WordMeaningID,
+		Card:          card,
+	}
+}
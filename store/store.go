@@ -0,0 +1,31 @@
+// Package store keeps the user data served by WordService, along with the
+// word meanings that user data refers to.
+package store
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned when a record does not exist or does not belong to
+// the requesting user.
+var ErrNotFound = errors.New("store: not found")
+
+// NewID returns a random 24 character hex id.
+func NewID() string {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		panic("store: read random id: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
+
+// WordMeaningID derives the id of a dictionary word meaning from the entry
+// it belongs to, so the same entry keeps its id however often it is loaded.
+func WordMeaningID(word, partOfSpeech string, orderByNo int32) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s\x00%s\x00%d", word, partOfSpeech, orderByNo)))
+	return hex.EncodeToString(sum[:12])
+}
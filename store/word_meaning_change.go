@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kakurineuin/learn-english-word/pb"
+)
+
+// ChangeAction is what a WordMeaningChange did to a dictionary entry.
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+// WordMeaningChange is an edit of a dictionary entry. The changes of an
+// entry are its revision history.
+type WordMeaningChange struct {
+	ID            string
+	WordMeaningID string
+	// UserID is the editor who made the change.
+	UserID string
+	Action ChangeAction
+	// Before is the entry before the change, nil for ChangeCreate; After is
+	// the entry after it, nil for ChangeDelete.
+	Before *pb.WordMeaning
+	After  *pb.WordMeaning
+	// Diff lists the fields the change set, in field number order.
+	Diff []FieldDiff
+	// RevertedChangeID is the change this one undid, if it was a revert.
+	RevertedChangeID string
+	CreatedAt        time.Time
+}
+
+// FieldDiff is a field of a WordMeaning set by a change, with its values in
+// protojson form; a value is empty when the field was unset.
+type FieldDiff struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+func (c *WordMeaningChange) cursor() cursor {
+	return newCursor(c.CreatedAt, c.ID)
+}
+
+func (c *WordMeaningChange) clone() *WordMeaningChange {
+	copied := *c
+	copied.Diff = slices.Clone(c.Diff)
+	if c.Before != nil {
+		copied.Before = proto.Clone(c.Before).(*pb.WordMeaning)
+	}
+	if c.After != nil {
+		copied.After = proto.Clone(c.After).(*pb.WordMeaning)
+	}
+	return &copied
+}
+
+// CreateWordMeaningChange records change, assigning its id and, when unset,
+// its creation time.
+func (m *Memory) CreateWordMeaningChange(
+	_ context.Context,
+	change *WordMeaningChange,
+) (*WordMeaningChange, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	created := change.clone()
+	created.ID = NewID()
+	if created.CreatedAt.IsZero() {
+		created.CreatedAt = m.now()
+	}
+	m.wordMeaningChanges[created.ID] = created
+	return created.clone(), nil
+}
+
+// FindWordMeaningChange returns the change id.
+func (m *Memory) FindWordMeaningChange(_ context.Context, id string) (*WordMeaningChange, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	change, ok := m.wordMeaningChanges[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return change.clone(), nil
+}
+
+// WordMeaningChangeQuery selects the changes listed by FindWordMeaningChanges.
+type WordMeaningChangeQuery struct {
+	WordMeaningID string
+	Page          Page
+}
+
+// FindWordMeaningChanges returns a page of the changes of an entry, newest
+// first, along with the next page token and the number of changes.
+func (m *Memory) FindWordMeaningChanges(
+	_ context.Context,
+	query WordMeaningChangeQuery,
+) ([]*WordMeaningChange, string, int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var changes []*WordMeaningChange
+	for _, change := range m.wordMeaningChanges {
+		if change.WordMeaningID == query.WordMeaningID {
+			changes = append(changes, change.clone())
+		}
+	}
+	sortBy(changes, newestFirst, (*WordMeaningChange).cursor)
+
+	page, next, err := paginate(changes, query.Page, newestFirst, (*WordMeaningChange).cursor)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return page, next, int64(len(changes)), nil
+}
@@ -0,0 +1,52 @@
+package suggest
+
+import "strings"
+
+var soundexCodes = map[rune]byte{
+	'b': '1', 'f': '1', 'p': '1', 'v': '1',
+	'c': '2', 'g': '2', 'j': '2', 'k': '2', 'q': '2', 's': '2', 'x': '2', 'z': '2',
+	'd': '3', 't': '3',
+	'l': '4',
+	'm': '5', 'n': '5',
+	'r': '6',
+}
+
+// Soundex returns the American Soundex code of word, such as "R163" for
+// "robert", or "" when word has no letter.
+func Soundex(word string) string {
+	var first rune
+	code := make([]byte, 0, 4)
+	var last byte
+	for _, r := range strings.ToLower(word) {
+		if r < 'a' || r > 'z' {
+			continue
+		}
+		digit := soundexCodes[r]
+		if first == 0 {
+			first = r
+			code = append(code, byte(r-'a'+'A'))
+			last = digit
+			continue
+		}
+		switch {
+		case digit == 0:
+			// Vowels separate letters with the same code; h and w do not.
+			if r != 'h' && r != 'w' {
+				last = 0
+			}
+		case digit != last:
+			code = append(code, digit)
+			last = digit
+		}
+		if len(code) == 4 {
+			break
+		}
+	}
+	if first == 0 {
+		return ""
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
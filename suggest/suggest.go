@@ -0,0 +1,192 @@
+// Package suggest finds dictionary headwords close to a misspelled word, by
+// edit distance and by sound.
+package suggest
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxDistance is the largest edit distance a suggestion may have.
+const maxDistance = 2
+
+// Index holds the headwords suggestions are drawn from. It is safe for
+// concurrent use.
+type Index struct {
+	mu      sync.RWMutex
+	words   map[string]bool
+	byLen   map[int][]string
+	bySound map[string][]string
+}
+
+// NewIndex returns an Index holding words.
+func NewIndex(words ...string) *Index {
+	i := &Index{
+		words:   make(map[string]bool),
+		byLen:   make(map[int][]string),
+		bySound: make(map[string][]string),
+	}
+	i.Add(words...)
+	return i
+}
+
+// Add puts words in the index.
+func (i *Index) Add(words ...string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word == "" || i.words[word] {
+			continue
+		}
+		i.words[word] = true
+		n := len([]rune(word))
+		i.byLen[n] = append(i.byLen[n], word)
+		if code := Soundex(word); code != "" {
+			i.bySound[code] = append(i.bySound[code], word)
+		}
+	}
+}
+
+// Remove takes words out of the index.
+func (i *Index) Remove(words ...string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if !i.words[word] {
+			continue
+		}
+		delete(i.words, word)
+		n := len([]rune(word))
+		i.byLen[n] = without(i.byLen[n], word)
+		if code := Soundex(word); code != "" {
+			i.bySound[code] = without(i.bySound[code], word)
+		}
+	}
+}
+
+type candidate struct {
+	word     string
+	distance int
+	sound    bool
+}
+
+// Suggest returns up to limit headwords close to word, best first. Closer
+// spellings rank higher; a word that sounds the same ranks above one at the
+// same distance that does not. word itself is never suggested.
+func (i *Index) Suggest(word string, limit int) []string {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if word == "" || limit <= 0 {
+		return nil
+	}
+	runes := []rune(word)
+	// Short words have too many neighbours two edits away.
+	allowed := maxDistance
+	if len(runes) <= 4 {
+		allowed = 1
+	}
+	code := Soundex(word)
+
+	i.mu.RLock()
+	candidates := make(map[string]*candidate)
+	for n := len(runes) - allowed; n <= len(runes)+allowed; n++ {
+		for _, other := range i.byLen[n] {
+			if d := distance(runes, []rune(other), allowed); d <= allowed {
+				candidates[other] = &candidate{word: other, distance: d}
+			}
+		}
+	}
+	if code != "" {
+		for _, other := range i.bySound[code] {
+			if c, ok := candidates[other]; ok {
+				c.sound = true
+			} else if d := distance(runes, []rune(other), allowed+1); d <= allowed+1 {
+				candidates[other] = &candidate{word: other, distance: d, sound: true}
+			}
+		}
+	}
+	i.mu.RUnlock()
+	delete(candidates, word)
+
+	ranked := make([]*candidate, 0, len(candidates))
+	for _, c := range candidates {
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(a, b int) bool {
+		if sa, sb := ranked[a].score(), ranked[b].score(); sa != sb {
+			return sa < sb
+		}
+		return ranked[a].word < ranked[b].word
+	})
+
+	suggestions := make([]string, 0, min(limit, len(ranked)))
+	for _, c := range ranked[:min(limit, len(ranked))] {
+		suggestions = append(suggestions, c.word)
+	}
+	return suggestions
+}
+
+func (c *candidate) score() int {
+	s := c.distance * 2
+	if c.sound {
+		s--
+	}
+	return s
+}
+
+// distance returns the optimal string alignment distance between a and b:
+// the number of insertions, deletions, substitutions and transpositions of
+// adjacent letters turning a into b. It gives up with limit+1 once the
+// distance is known to exceed limit.
+func distance(a, b []rune, limit int) int {
+	if abs(len(a)-len(b)) > limit {
+		return limit + 1
+	}
+
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(b)]
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func without(words []string, word string) []string {
+	for i, w := range words {
+		if w == word {
+			return append(words[:i], words[i+1:]...)
+		}
+	}
+	return words
+}